@@ -0,0 +1,373 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// Address is a structured form of a free text address, e.g.
+// "01034, м.Київ, Шевченківський район, ВУЛИЦЯ ЯРОСЛАВІВ ВАЛ, будинок 55, корпус Б".
+// Names keep the spelling of the source, types are normalized to full words.
+type Address struct {
+	Raw            string   `json:"raw"`             // Вихідний текст
+	PostalCode     string   `json:"postal_code"`     // Поштовий індекс
+	Country        string   `json:"country"`         // Країна
+	Region         Region   `json:"region"`          // Регіон, 0 якщо не визначено
+	District       string   `json:"district"`        // Район області
+	SettlementType string   `json:"settlement_type"` // місто, селище міського типу, село, селище, хутір
+	Settlement     string   `json:"settlement"`      // Населений пункт
+	CityDistrict   string   `json:"city_district"`   // Район міста
+	StreetType     string   `json:"street_type"`     // вулиця, проспект, провулок, бульвар...
+	Street         string   `json:"street"`          // Назва вулиці
+	Building       string   `json:"building"`        // Будинок
+	Corpus         string   `json:"corpus"`          // Корпус
+	Apartment      string   `json:"apartment"`       // Квартира, офіс або приміщення
+	Koatuu         string   `json:"koatuu"`          // Код КОАТУУ, див. ResolveKoatuu
+	KoatuuType     string   `json:"koatuu_type"`     // Тип об'єкта КОАТУУ: city, city-district, region-district...
+	Unparsed       []string `json:"unparsed"`        // Нерозпізнані частини
+}
+
+var settlementTypes = map[string]string{
+	"м":       "місто",
+	"місто":   "місто",
+	"смт":     "селище міського типу",
+	"с":       "село",
+	"село":    "село",
+	"сел":     "селище",
+	"селище":  "селище",
+	"с-ще":    "селище",
+	"х":       "хутір",
+	"хутір":   "хутір",
+	"city":    "місто",
+	"village": "село",
+}
+
+var streetTypes = map[string]string{
+	"вулиця":     "вулиця",
+	"вул":        "вулиця",
+	"проспект":   "проспект",
+	"просп":      "проспект",
+	"пр-т":       "проспект",
+	"пр":         "проспект",
+	"провулок":   "провулок",
+	"пров":       "провулок",
+	"бульвар":    "бульвар",
+	"бульв":      "бульвар",
+	"бул":        "бульвар",
+	"площа":      "площа",
+	"пл":         "площа",
+	"шосе":       "шосе",
+	"узвіз":      "узвіз",
+	"набережна":  "набережна",
+	"наб":        "набережна",
+	"проїзд":     "проїзд",
+	"тупик":      "тупик",
+	"майдан":     "майдан",
+	"алея":       "алея",
+	"спуск":      "спуск",
+	"квартал":    "квартал",
+	"мікрорайон": "мікрорайон",
+	"мкр":        "мікрорайон",
+	"мкрн":       "мікрорайон",
+	"в'їзд":      "в'їзд",
+	"лінія":      "лінія",
+	"дорога":     "дорога",
+}
+
+const (
+	addressBuilding  = "building"
+	addressCorpus    = "corpus"
+	addressApartment = "apartment"
+)
+
+var addressNumbers = map[string]string{
+	"будинок":    addressBuilding,
+	"буд":        addressBuilding,
+	"б":          addressBuilding,
+	"корпус":     addressCorpus,
+	"корп":       addressCorpus,
+	"к":          addressCorpus,
+	"квартира":   addressApartment,
+	"кв":         addressApartment,
+	"офіс":       addressApartment,
+	"оф":         addressApartment,
+	"приміщення": addressApartment,
+	"прим":       addressApartment,
+}
+
+var (
+	// addressMarker splits a segment into a leading word and the rest: "вул.Шевченка", "буд. 5"
+	addressMarker = regexp.MustCompile(`^([\p{L}'\-]+)\.?\s*(.*)$`)
+	// postalCodePattern matches a Ukrainian postal code
+	postalCodePattern = regexp.MustCompile(`^\d{5}$`)
+	// houseNumberPattern matches building numbers such as 55, 55Б, 5/2, 12-А
+	houseNumberPattern = regexp.MustCompile(`^\d+[\p{L}\d/\-]*$`)
+	// districtSuffix matches the district marker at the end of a segment
+	districtSuffix = regexp.MustCompile(`(?i)\s+(район|р-н|р\.?)$`)
+	// regionSuffix matches the region marker at the end of a segment
+	regionSuffix = regexp.MustCompile(`(?i)\s+(область|обл\.?)$`)
+)
+
+// splitMarker
+// Leading marker word in lower case and the rest of the segment
+func splitMarker(segment string) (string, string) {
+	if lower := strings.ToLower(segment); strings.HasPrefix(lower, "с.м.т.") {
+		return "смт", strings.TrimSpace(segment[len("с.м.т."):])
+	}
+
+	match := addressMarker.FindStringSubmatch(segment)
+
+	if match == nil {
+		return "", segment
+	}
+
+	return strings.ToLower(apostrophes.Replace(match[1])), strings.TrimSpace(match[2])
+}
+
+// ParseAddress
+// Parses a comma separated address as used by the registries.
+// Parts that cannot be recognized are kept in Unparsed.
+func ParseAddress(value string) Address {
+	address := Address{Raw: value}
+
+	for _, segment := range strings.Split(value, ",") {
+		segment = strings.TrimSpace(segment)
+
+		if segment != "" {
+			address.parseSegment(segment)
+		}
+	}
+
+	// Kyiv and Sevastopol are regions of their own, though realty names
+	// often put the oblast before them: "Київська обл., м. Київ"
+	if address.Settlement != "" && (address.Region == 0 || address.SettlementType == "місто") {
+		if region, err := ParseRegion(address.Settlement); err == nil && (region == RegionKyiv || region == RegionSevastopol) {
+			address.Region = region
+		}
+	}
+
+	return address
+}
+
+func (a *Address) parseSegment(segment string) {
+	lower := strings.ToLower(segment)
+
+	switch {
+	case postalCodePattern.MatchString(segment):
+		a.PostalCode = segment
+		return
+	case lower == "україна" || lower == "ukraine":
+		a.Country = segment
+		return
+	case regionSuffix.MatchString(segment) || strings.Contains(lower, "республіка"):
+		if region, err := ParseRegion(segment); err == nil {
+			a.Region = region
+			return
+		}
+	case districtSuffix.MatchString(segment):
+		name := strings.TrimSpace(districtSuffix.ReplaceAllString(segment, ""))
+
+		if a.Settlement == "" {
+			a.District = name
+		} else {
+			a.CityDistrict = name
+		}
+
+		return
+	case houseNumberPattern.MatchString(segment) && a.Street != "" && a.Building == "":
+		a.Building = segment
+		return
+	}
+
+	marker, rest := splitMarker(segment)
+
+	if kind, ok := settlementTypes[marker]; ok && rest != "" && a.Settlement == "" {
+		a.SettlementType, a.Settlement = kind, rest
+		return
+	}
+
+	if kind, ok := streetTypes[marker]; ok && rest != "" && a.Street == "" {
+		a.StreetType, a.Street = kind, rest
+		a.splitHouseNumber()
+		return
+	}
+
+	if kind, ok := addressNumbers[marker]; ok && rest != "" {
+		switch kind {
+		case addressBuilding:
+			a.Building = rest
+		case addressCorpus:
+			a.Corpus = rest
+		case addressApartment:
+			a.Apartment = rest
+		}
+
+		return
+	}
+
+	// The street type may follow the name: "Ярославів Вал вул."
+	words := strings.Fields(segment)
+
+	if len(words) > 1 && a.Street == "" {
+		last := strings.ToLower(strings.TrimSuffix(words[len(words)-1], "."))
+
+		if kind, ok := streetTypes[last]; ok {
+			a.StreetType, a.Street = kind, strings.Join(words[:len(words)-1], " ")
+			return
+		}
+	}
+
+	if a.Settlement == "" && a.Street == "" {
+		a.Settlement = segment
+		return
+	}
+
+	a.Unparsed = append(a.Unparsed, segment)
+}
+
+// splitHouseNumber
+// Moves a trailing building number written together with the street: "вул. Шевченка 5"
+func (a *Address) splitHouseNumber() {
+	words := strings.Fields(a.Street)
+
+	if len(words) < 2 || a.Building != "" {
+		return
+	}
+
+	if last := words[len(words)-1]; houseNumberPattern.MatchString(last) {
+		a.Street, a.Building = strings.Join(words[:len(words)-1], " "), last
+	}
+}
+
+// Key
+// Normalized form to compare addresses: lower case, unified apostrophes,
+// without the postal code and the country
+func (a *Address) Key() string {
+	parts := []string{a.SettlementType, a.Settlement, a.StreetType, a.Street, a.Building, a.Corpus, a.Apartment}
+
+	for i, part := range parts {
+		parts[i] = strings.Join(strings.Fields(strings.ToLower(apostrophes.Replace(part))), " ")
+	}
+
+	return strings.Join(parts, "|")
+}
+
+// sameKoatuuName
+// Compares a parsed name with a KOATUU name ignoring case, apostrophes and the district marker
+func sameKoatuuName(name, koatuuName string) bool {
+	normalize := func(value string) string {
+		value = districtSuffix.ReplaceAllString(strings.TrimSpace(value), "")
+
+		return strings.ToLower(apostrophes.Replace(value))
+	}
+
+	return name != "" && normalize(name) == normalize(koatuuName)
+}
+
+// ResolveKoatuu
+// Finds the most precise object of the address in the region data returned
+// by GetKoatuuRegionsByCode: city district, city, or the district of the region
+// when the settlement is not listed there
+func (a *Address) ResolveKoatuu(koatuu *Koatuu) bool {
+	data := koatuu.Data
+
+	set := func(code, kind string) bool {
+		a.Koatuu, a.KoatuuType = code, kind
+
+		if a.Region == 0 {
+			a.Region, _ = RegionByKoatuu(code)
+		}
+
+		return true
+	}
+
+	for _, city := range data.Items.City {
+		if !sameKoatuuName(a.Settlement, city.Name) {
+			continue
+		}
+
+		for _, district := range city.Districts {
+			if sameKoatuuName(a.CityDistrict, district.Name) {
+				return set(district.Code, district.Type)
+			}
+		}
+
+		return set(city.Code, city.Type)
+	}
+
+	for _, city := range data.Items.CityAndDistrict {
+		if sameKoatuuName(a.Settlement, city.Name) {
+			return set(city.Code, city.Type)
+		}
+	}
+
+	// Cities with the status of a region list their districts directly
+	if sameKoatuuName(a.Settlement, data.Name) {
+		for _, district := range data.Items.RegionDistrict {
+			if sameKoatuuName(a.CityDistrict, district.Name) {
+				return set(district.Code, district.Type)
+			}
+		}
+
+		return set(data.Code, data.Type)
+	}
+
+	for _, district := range data.Items.RegionDistrict {
+		if sameKoatuuName(a.District, district.Name) {
+			return set(district.Code, district.Type)
+		}
+	}
+
+	return false
+}
+
+// ResolveAddressKoatuu
+// Requests KOATUU data of the address region and resolves the address with it
+func (odb *OdbClient) ResolveAddressKoatuu(address *Address) (bool, error) {
+	if !address.Region.IsValid() {
+		return false, errors.New("Region of the address is unknown")
+	}
+
+	koatuu, err := odb.GetKoatuuRegionsByCode(address.Region.KoatuuCode())
+
+	if err != nil {
+		return false, err
+	}
+
+	return address.ResolveKoatuu(koatuu), nil
+}
+
+// ParsedLocation
+// Structured company address
+func (c *CompanyData) ParsedLocation() Address {
+	return ParseAddress(c.Location)
+}
+
+// ParsedLocation
+// Structured address of the registered company
+func (r *Registration) ParsedLocation() Address {
+	return ParseAddress(r.Location)
+}
+
+// ParsedAddress
+// Structured address of the inspected object
+func (i *Inspection) ParsedAddress() Address {
+	return ParseAddress(i.Address)
+}
+
+// ParsedLocation
+// Structured address of the beneficiary
+func (b *Beneficiary) ParsedLocation() Address {
+	return ParseAddress(b.Location)
+}
+
+// ParsedName
+// Structured address of the property, the API sends it as the name
+func (r *RealtyListItem) ParsedName() Address {
+	return ParseAddress(r.Name)
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		value string
+		want  Address
+	}{
+		{
+			"01034, м.Київ, Шевченківський район, ВУЛИЦЯ ЯРОСЛАВІВ ВАЛ, будинок 55, корпус Б",
+			Address{PostalCode: "01034", Region: RegionKyiv, SettlementType: "місто", Settlement: "Київ", CityDistrict: "Шевченківський",
+				StreetType: "вулиця", Street: "ЯРОСЛАВІВ ВАЛ", Building: "55", Corpus: "Б"},
+		},
+		{
+			"Київська обл., м. Київ, вулиця Дзержинського, будинок 71",
+			Address{Region: RegionKyiv, SettlementType: "місто", Settlement: "Київ", StreetType: "вулиця", Street: "Дзержинського", Building: "71"},
+		},
+		{
+			"08130, Київська обл., Києво-Святошинський р-н, с. Петропавлівська Борщагівка, вул. Соборна, буд. 2, кв. 15",
+			Address{PostalCode: "08130", Region: RegionKyivOblast, District: "Києво-Святошинський", SettlementType: "село", Settlement: "Петропавлівська Борщагівка",
+				StreetType: "вулиця", Street: "Соборна", Building: "2", Apartment: "15"},
+		},
+		{
+			"22800, Вінницька область, Немирівський район, смт Брацлав, вул. Шевченка, 1",
+			Address{PostalCode: "22800", Region: RegionVinnytsia, District: "Немирівський", SettlementType: "селище міського типу", Settlement: "Брацлав",
+				StreetType: "вулиця", Street: "Шевченка", Building: "1"},
+		},
+		{
+			"Львівська обл., Пустомитівський район, село Сокільники, вулиця Шевченка, 5",
+			Address{Region: RegionLviv, District: "Пустомитівський", SettlementType: "село", Settlement: "Сокільники", StreetType: "вулиця", Street: "Шевченка", Building: "5"},
+		},
+		{
+			"Україна, 61000, Харківська обл., м. Харків, просп. Науки, буд. 10, офіс 3",
+			Address{PostalCode: "61000", Country: "Україна", Region: RegionKharkiv, SettlementType: "місто", Settlement: "Харків",
+				StreetType: "проспект", Street: "Науки", Building: "10", Apartment: "3"},
+		},
+	}
+
+	for _, test := range tests {
+		test.want.Raw = test.value
+		got := ParseAddress(test.value)
+
+		if len(got.Unparsed) == 0 {
+			got.Unparsed = nil
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ParseAddress(%q)\n got %+v\nwant %+v", test.value, got, test.want)
+		}
+	}
+}
+
+func TestRealtyListItemParsedName(t *testing.T) {
+	var realty RealtySuccess
+
+	data := `{"status":"ok","data":{"count":"1","reportResultId":"5001395171432","items":[
+		{"dcGroupType":"1","name":"Київська обл., м. Київ, вулиця Дзержинського, будинок 71","id":"45035236"}]}}`
+
+	if err := json.Unmarshal([]byte(data), &realty); err != nil {
+		t.Fatal(err)
+	}
+
+	address := realty.Data.Items[0].ParsedName()
+
+	if address.Region != RegionKyiv || address.Settlement != "Київ" || address.Street != "Дзержинського" || address.Building != "71" {
+		t.Errorf("address %+v", address)
+	}
+}
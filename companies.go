@@ -0,0 +1,250 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// CompaniesOptions configures GetCompanies
+type CompaniesOptions struct {
+	ChunkSize     int  // Кількість кодів в одному запиті
+	Concurrency   int  // Кількість одночасних запитів
+	SplitRejected bool // Запитувати коди відхиленої частини по одному
+}
+
+// DefaultCompaniesOptions
+// 50 codes per request, 4 requests at a time, rejected chunks are not split
+func DefaultCompaniesOptions() CompaniesOptions {
+	return CompaniesOptions{
+		ChunkSize:   50,
+		Concurrency: 4,
+	}
+}
+
+// CompaniesResult is the outcome of GetCompanies
+type CompaniesResult struct {
+	Codes     []EDRPOU               // Коректні коди в порядку запиту, без повторів
+	Companies map[EDRPOU]CompanyData // Знайдені компанії
+	NotFound  []EDRPOU               // Коди, за якими немає запису
+	Errors    map[EDRPOU]error       // Коди, які не вдалося отримати
+}
+
+// Ordered
+// Found companies in the order of the requested codes
+func (r *CompaniesResult) Ordered() []CompanyData {
+	companies := make([]CompanyData, 0, len(r.Companies))
+
+	for _, code := range r.Codes {
+		if company, ok := r.Companies[code]; ok {
+			companies = append(companies, company)
+		}
+	}
+
+	return companies
+}
+
+// CompaniesError reports codes that failed in GetCompanies
+type CompaniesError struct {
+	Errors map[EDRPOU]error
+}
+
+func (e *CompaniesError) Error() string {
+	codes := make([]string, 0, len(e.Errors))
+
+	for code := range e.Errors {
+		codes = append(codes, string(code))
+	}
+
+	sort.Strings(codes)
+
+	return fmt.Sprintf("failed to get %d companies: %s", len(codes), strings.Join(codes, ", "))
+}
+
+// GetCompanies
+// Looks up companies by codes in chunks of options.ChunkSize, at most
+// options.Concurrency requests at a time; zero values take DefaultCompaniesOptions.
+// Invalid codes and failed requests are reported per code in
+// CompaniesResult.Errors and as *CompaniesError, successful results are kept.
+// With options.SplitRejected the codes of a rejected chunk are requested one by one,
+// so a single bad code does not fail its neighbours.
+func (odb *OdbClient) GetCompanies(ctx context.Context, codes []EDRPOU, options CompaniesOptions) (*CompaniesResult, error) {
+	if err := checkApiKey(odb); err != nil {
+		return nil, err
+	}
+
+	if options.ChunkSize <= 0 {
+		options.ChunkSize = DefaultCompaniesOptions().ChunkSize
+	}
+
+	if options.Concurrency <= 0 {
+		options.Concurrency = DefaultCompaniesOptions().Concurrency
+	}
+
+	result := &CompaniesResult{
+		Companies: map[EDRPOU]CompanyData{},
+		Errors:    map[EDRPOU]error{},
+	}
+
+	var (
+		valid []EDRPOU
+		seen  = map[EDRPOU]bool{}
+	)
+
+	for _, code := range codes {
+		parsed, err := ParseEDRPOU(string(code))
+
+		if err != nil {
+			result.Errors[code] = err
+			continue
+		}
+
+		if !seen[parsed] {
+			seen[parsed] = true
+			valid = append(valid, parsed)
+		}
+	}
+
+	var (
+		mutex     sync.Mutex
+		waitGroup sync.WaitGroup
+		semaphore = make(chan struct{}, options.Concurrency)
+	)
+
+	result.Codes = valid
+
+	for start := 0; start < len(valid); start += options.ChunkSize {
+		end := start + options.ChunkSize
+
+		if end > len(valid) {
+			end = len(valid)
+		}
+
+		chunk := valid[start:end]
+
+		waitGroup.Add(1)
+
+		go func() {
+			defer waitGroup.Done()
+
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				mutex.Lock()
+				for _, code := range chunk {
+					result.Errors[code] = ctx.Err()
+				}
+				mutex.Unlock()
+
+				return
+			}
+
+			companies, errs := odb.getCompaniesChunk(ctx, chunk, options.SplitRejected)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			for _, company := range companies {
+				if code, err := ParseEDRPOU(company.Code); err == nil && seen[code] {
+					result.Companies[code] = company
+				}
+			}
+
+			for code, err := range errs {
+				result.Errors[code] = err
+			}
+		}()
+	}
+
+	waitGroup.Wait()
+
+	for _, code := range valid {
+		if _, ok := result.Companies[code]; ok {
+			continue
+		}
+
+		if _, ok := result.Errors[code]; ok {
+			continue
+		}
+
+		result.NotFound = append(result.NotFound, code)
+	}
+
+	if len(result.Errors) > 0 {
+		return result, &CompaniesError{Errors: result.Errors}
+	}
+
+	return result, nil
+}
+
+// getCompaniesChunk
+// Requests the chunk at once and, when split is set, falls back to single codes if it is rejected
+func (odb *OdbClient) getCompaniesChunk(ctx context.Context, chunk []EDRPOU, split bool) ([]CompanyData, map[EDRPOU]error) {
+	companies, err := odb.getCompanies(ctx, chunk)
+
+	if err == nil {
+		return companies, nil
+	}
+
+	errs := map[EDRPOU]error{}
+
+	var apiErr *ApiError
+
+	isApiErr := errors.As(err, &apiErr)
+
+	if len(chunk) == 1 && isApiErr && apiErr.IsNotFound() {
+		return nil, nil
+	}
+
+	// Transport failures, rate limits and server errors are not caused by the codes
+	// and are reported for the whole chunk
+	if !split || len(chunk) == 1 || !isApiErr || apiErr.IsRateLimited() || apiErr.StatusCode >= 500 {
+		for _, code := range chunk {
+			errs[code] = err
+		}
+
+		return nil, errs
+	}
+
+	for _, code := range chunk {
+		single, err := odb.getCompanies(ctx, []EDRPOU{code})
+
+		if err != nil {
+			var apiErr *ApiError
+
+			if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+				continue
+			}
+
+			errs[code] = err
+			continue
+		}
+
+		companies = append(companies, single...)
+	}
+
+	return companies, errs
+}
+
+func (odb *OdbClient) getCompanies(ctx context.Context, codes []EDRPOU) (response []CompanyData, err error) {
+	joined := make([]string, len(codes))
+
+	for i, code := range codes {
+		joined[i] = string(code)
+	}
+
+	endpoint := fmt.Sprintf(companyEndpoint, strings.Join(joined, ","))
+
+	err = odb.DoContext(ctx, http.MethodGet, endpoint, map[string]string{}, &response)
+
+	return response, err
+}
@@ -0,0 +1,156 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// companiesServer answers company requests for every code except the missing ones,
+// a request with a rejected code fails with status
+type companiesServer struct {
+	mutex    sync.Mutex
+	missing  map[string]bool
+	rejected map[string]bool
+	status   int
+	chunks   []int
+	active   int
+	peak     int
+}
+
+func (s *companiesServer) handle(req *http.Request) (int, interface{}) {
+	codes := strings.Split(strings.TrimPrefix(req.URL.Path, "/api/v2/company/"), ",")
+
+	s.mutex.Lock()
+	s.chunks = append(s.chunks, len(codes))
+
+	if s.active++; s.active > s.peak {
+		s.peak = s.active
+	}
+
+	s.mutex.Unlock()
+
+	time.Sleep(time.Millisecond)
+
+	s.mutex.Lock()
+	s.active--
+	s.mutex.Unlock()
+
+	companies := []CompanyData{}
+
+	for _, code := range codes {
+		if s.rejected[code] {
+			return s.status, map[string]string{"status": "error"}
+		}
+
+		if !s.missing[code] {
+			companies = append(companies, CompanyData{Code: code})
+		}
+	}
+
+	if len(companies) == 0 {
+		return http.StatusNotFound, map[string]string{"status": "error"}
+	}
+
+	return http.StatusOK, companies
+}
+
+func TestGetCompaniesChunks(t *testing.T) {
+	server := &companiesServer{missing: map[string]bool{"00032129": true}}
+	client := newTestClient(t, server.handle)
+	codes := []EDRPOU{"41711425", "32746583", "1436 0570", "14360571", "32129", "10000001", "35000004", "41711425", "65000005"}
+
+	result, err := client.GetCompanies(context.Background(), codes, CompaniesOptions{ChunkSize: 3, Concurrency: 2})
+
+	var companiesErr *CompaniesError
+
+	if !errors.As(err, &companiesErr) || len(result.Errors) != 1 || result.Errors["14360571"] == nil {
+		t.Fatalf("errors %v", err)
+	}
+
+	sort.Ints(server.chunks)
+
+	if !reflect.DeepEqual(server.chunks, []int{1, 3, 3}) || server.peak > 2 {
+		t.Errorf("chunks %v, %d at a time", server.chunks, server.peak)
+	}
+
+	var ordered []string
+
+	for _, company := range result.Ordered() {
+		ordered = append(ordered, company.Code)
+	}
+
+	want := []string{"41711425", "32746583", "14360570", "10000001", "35000004", "65000005"}
+
+	if !reflect.DeepEqual(ordered, want) {
+		t.Errorf("ordered %v, want %v", ordered, want)
+	}
+
+	if !reflect.DeepEqual(result.NotFound, []EDRPOU{"00032129"}) {
+		t.Errorf("not found %v", result.NotFound)
+	}
+}
+
+func TestGetCompaniesRejectedChunk(t *testing.T) {
+	codes := []EDRPOU{"41711425", "32746583", "14360570", "10000001"}
+
+	tests := []struct {
+		name    string
+		status  int
+		split   bool
+		found   int
+		failed  []EDRPOU
+		missing []EDRPOU
+	}{
+		{"not split", http.StatusBadRequest, false, 2, []EDRPOU{"41711425", "32746583"}, nil},
+		{"split", http.StatusBadRequest, true, 3, []EDRPOU{"32746583"}, nil},
+		{"split not found", http.StatusNotFound, true, 3, nil, []EDRPOU{"32746583"}},
+		{"server error", http.StatusInternalServerError, true, 2, []EDRPOU{"41711425", "32746583"}, nil},
+	}
+
+	for _, test := range tests {
+		server := &companiesServer{rejected: map[string]bool{"32746583": true}, status: test.status}
+		client := newTestClient(t, server.handle)
+
+		result, err := client.GetCompanies(context.Background(), codes, CompaniesOptions{ChunkSize: 2, SplitRejected: test.split})
+
+		if (err != nil) != (len(test.failed) > 0) || len(result.Companies) != test.found || len(result.Errors) != len(test.failed) {
+			t.Errorf("%s: %d found, %v", test.name, len(result.Companies), err)
+			continue
+		}
+
+		for _, code := range test.failed {
+			var apiErr *ApiError
+
+			if !errors.As(result.Errors[code], &apiErr) || apiErr.StatusCode != test.status {
+				t.Errorf("%s: %s error %v", test.name, code, result.Errors[code])
+			}
+		}
+
+		if !reflect.DeepEqual(result.NotFound, test.missing) {
+			t.Errorf("%s: not found %v", test.name, result.NotFound)
+		}
+	}
+}
+
+func TestGetCompaniesCanceled(t *testing.T) {
+	client := newTestClient(t, (&companiesServer{}).handle)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := client.GetCompanies(ctx, []EDRPOU{"41711425", "32746583"}, DefaultCompaniesOptions())
+
+	if err == nil || len(result.Companies) != 0 || !errors.Is(result.Errors["41711425"], context.Canceled) {
+		t.Errorf("result %+v, %v", result, err)
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// courtParams are the query parameters SearchCourt may send to GetCourt
+var courtParams = []string{
+	"judgment_code",
+	"justice_code",
+	"court_code",
+	"company_code",
+	"text",
+	"stage",
+	"text_intro",
+	"text_resolution",
+	"offset",
+	"limit",
+	"date_from",
+	"date_to",
+	"number",
+	"search_criteria",
+}
+
+// JudgmentForm is a form of judicial proceedings (judgment_code)
+type JudgmentForm int
+
+const (
+	JudgmentCivil          JudgmentForm = 1 // Цивільне
+	JudgmentCriminal       JudgmentForm = 2 // Кримінальне
+	JudgmentCommercial     JudgmentForm = 3 // Господарське
+	JudgmentAdministrative JudgmentForm = 4 // Адміністративне
+	JudgmentAdminOffense   JudgmentForm = 5 // Адмінправопорушення
+)
+
+// IsValid
+// Reports whether the form is documented by the API
+func (f JudgmentForm) IsValid() bool {
+	return f >= JudgmentCivil && f <= JudgmentAdminOffense
+}
+
+// JusticeKind is a kind of court document (justice_code)
+type JusticeKind int
+
+const (
+	JusticeVerdict         JusticeKind = 1  // Вирок
+	JusticeResolution      JusticeKind = 2  // Постанова
+	JusticeDecision        JusticeKind = 3  // Рішення
+	JusticeCourtOrder      JusticeKind = 4  // Судовий наказ
+	JusticeRuling          JusticeKind = 5  // Ухвала
+	JusticeSeparateRuling  JusticeKind = 6  // Окрема ухвала
+	JusticeSeparateOpinion JusticeKind = 10 // Окрема думка
+)
+
+// IsValid
+// Reports whether the kind is documented by the API
+func (k JusticeKind) IsValid() bool {
+	return (k >= JusticeVerdict && k <= JusticeSeparateRuling) || k == JusticeSeparateOpinion
+}
+
+// Stage is a court instance
+type Stage string
+
+const (
+	StageFirst     Stage = "first"     // Перша інстанція
+	StageAppeal    Stage = "appeal"    // Апеляція
+	StageCassation Stage = "cassation" // Касація
+)
+
+// IsValid
+// Reports whether the stage is documented by the API
+func (s Stage) IsValid() bool {
+	return s == StageFirst || s == StageAppeal || s == StageCassation
+}
+
+// SearchCriteria is a criterion of matching text in the court decision
+type SearchCriteria string
+
+const (
+	SearchWordsInARow SearchCriteria = "words_in_a_row" // Слова повинні йти один за одним
+)
+
+// IsValid
+// Reports whether the criteria is documented by the API
+func (c SearchCriteria) IsValid() bool {
+	return c == SearchWordsInARow
+}
+
+// CourtSearchQuery is a typed set of GetCourt parameters.
+// Zero values are omitted.
+type CourtSearchQuery struct {
+	JudgmentForm   JudgmentForm   // Форма судочинства
+	JusticeKind    JusticeKind    // Форма судового рішення
+	CourtCode      string         // Код суда (перелік в судових реєстрах по /institutions)
+	CompanyCode    string         // Код ЄДРПОУ компанії
+	Text           string         // Пошук в тексті рішення
+	Stage          Stage          // Тип інстанції
+	TextIntro      string         // Пошук в вступній частині рішення
+	TextResolution string         // Пошук в резолютивній частині рішення
+	SearchCriteria SearchCriteria // Критерій пошуку значення Text
+	DateFrom       time.Time      // Дата ухвали рішення з, за київським часом
+	DateTo         time.Time      // Дата ухвали рішення по, за київським часом
+	Number         string         // Номер справи
+	Offset         int            // Зміщення відносно початку результатів пошуку
+	Limit          int            // Кількість записів
+}
+
+// Validate
+// Checks enum values, date bounds and paging
+func (q *CourtSearchQuery) Validate() error {
+	if q.JudgmentForm != 0 && !q.JudgmentForm.IsValid() {
+		return fmt.Errorf("Unknown judgment form %d", q.JudgmentForm)
+	}
+
+	if q.JusticeKind != 0 && !q.JusticeKind.IsValid() {
+		return fmt.Errorf("Unknown justice kind %d", q.JusticeKind)
+	}
+
+	if q.Stage != "" && !q.Stage.IsValid() {
+		return fmt.Errorf("Unknown stage %s", q.Stage)
+	}
+
+	if q.SearchCriteria != "" && !q.SearchCriteria.IsValid() {
+		return fmt.Errorf("Unknown search criteria %s", q.SearchCriteria)
+	}
+
+	if q.SearchCriteria != "" && q.Text == "" {
+		return errors.New("Search criteria requires text")
+	}
+
+	if !q.DateFrom.IsZero() && !q.DateTo.IsZero() && q.DateFrom.After(q.DateTo) {
+		return errors.New("DateFrom is after DateTo")
+	}
+
+	if q.Offset < 0 || q.Limit < 0 {
+		return errors.New("Offset and limit must not be negative")
+	}
+
+	return nil
+}
+
+// Params
+// Query parameters for GetCourt
+func (q *CourtSearchQuery) Params() (params map[string]string, err error) {
+	if err = q.Validate(); err != nil {
+		return nil, err
+	}
+
+	params = map[string]string{}
+
+	if q.JudgmentForm != 0 {
+		params["judgment_code"] = strconv.Itoa(int(q.JudgmentForm))
+	}
+
+	if q.JusticeKind != 0 {
+		params["justice_code"] = strconv.Itoa(int(q.JusticeKind))
+	}
+
+	setParam(params, "court_code", q.CourtCode)
+	setParam(params, "company_code", q.CompanyCode)
+	setParam(params, "text", q.Text)
+	setParam(params, "stage", string(q.Stage))
+	setParam(params, "text_intro", q.TextIntro)
+	setParam(params, "text_resolution", q.TextResolution)
+	setParam(params, "search_criteria", string(q.SearchCriteria))
+	setParam(params, "number", q.Number)
+	setParam(params, "date_from", kyivDate(q.DateFrom))
+	setParam(params, "date_to", kyivDate(q.DateTo))
+
+	if q.Offset != 0 {
+		params["offset"] = strconv.Itoa(q.Offset)
+	}
+
+	if q.Limit != 0 {
+		params["limit"] = strconv.Itoa(q.Limit)
+	}
+
+	return params, nil
+}
+
+// Encode
+// Query string sorted by key, so the same query always encodes the same way
+func (q *CourtSearchQuery) Encode() (string, error) {
+	params, err := q.Params()
+
+	if err != nil {
+		return "", err
+	}
+
+	return encodeParams(params), nil
+}
+
+func encodeParams(params map[string]string) string {
+	values := url.Values{}
+
+	for key, value := range params {
+		values.Set(key, value)
+	}
+
+	return values.Encode()
+}
+
+func setParam(params map[string]string, key, value string) {
+	if value != "" {
+		params[key] = value
+	}
+}
+
+// SearchCourt
+// Отримання судових рішень за типізованим запитом
+func (odb *OdbClient) SearchCourt(query CourtSearchQuery) (*CourtDecisions, error) {
+	params, err := query.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkKnownParams(params, courtParams); err != nil {
+		return nil, err
+	}
+
+	return odb.GetCourt(params)
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCourtSearchQueryParams(t *testing.T) {
+	query := CourtSearchQuery{
+		JudgmentForm:   JudgmentCommercial,
+		JusticeKind:    JusticeSeparateOpinion,
+		CompanyCode:    "41711425",
+		Text:           "поставка",
+		SearchCriteria: SearchWordsInARow,
+		Stage:          StageAppeal,
+		DateFrom:       time.Date(2021, 12, 31, 22, 30, 0, 0, time.UTC),
+		DateTo:         time.Date(2022, 1, 31, 23, 59, 0, 0, Kyiv),
+		Offset:         20,
+		Limit:          10,
+	}
+
+	params, err := query.Params()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"judgment_code":   "3",
+		"justice_code":    "10",
+		"company_code":    "41711425",
+		"text":            "поставка",
+		"search_criteria": "words_in_a_row",
+		"stage":           "appeal",
+		"date_from":       "2022-01-01",
+		"date_to":         "2022-01-31",
+		"offset":          "20",
+		"limit":           "10",
+	}
+
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("params %v, want %v", params, want)
+	}
+
+	encoded, err := (&CourtSearchQuery{Number: "910/1/22", Stage: StageFirst}).Encode()
+
+	if err != nil || encoded != "number=910%2F1%2F22&stage=first" {
+		t.Errorf("encoded %q, %v", encoded, err)
+	}
+
+	invalid := []CourtSearchQuery{
+		{JudgmentForm: 6},
+		{JusticeKind: 7},
+		{Stage: "supreme"},
+		{SearchCriteria: "any"},
+		{SearchCriteria: SearchWordsInARow},
+		{DateFrom: time.Date(2022, 2, 1, 0, 0, 0, 0, Kyiv), DateTo: time.Date(2022, 1, 1, 0, 0, 0, 0, Kyiv)},
+		{Limit: -1},
+	}
+
+	for _, query := range invalid {
+		if _, err := query.Params(); err == nil {
+			t.Errorf("query %+v accepted", query)
+		}
+	}
+}
+
+func TestSearchCourt(t *testing.T) {
+	var query map[string]string
+
+	client := newTestClient(t, func(req *http.Request) (int, interface{}) {
+		query = map[string]string{}
+
+		for key := range req.URL.Query() {
+			query[key] = req.URL.Query().Get(key)
+		}
+
+		return http.StatusOK, map[string]interface{}{"status": "ok"}
+	})
+
+	if _, err := client.SearchCourt(CourtSearchQuery{CompanyCode: "41711425", JudgmentForm: JudgmentCivil}); err != nil {
+		t.Fatal(err)
+	}
+
+	if query["company_code"] != "41711425" || query["judgment_code"] != "1" {
+		t.Errorf("query %v", query)
+	}
+
+	// raw parameters are passed as given, including ones added to the API later
+	if _, err := client.GetCourt(map[string]string{"company_code": "41711425", "order": "date"}); err != nil || query["order"] != "date" {
+		t.Errorf("raw query %v, %v", query, err)
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Kyiv is the time zone of dates and times sent without an offset.
+// It is loaded from the system zoneinfo; on hosts without it the
+// current EET/EEST rule is used. Programs that need the full history
+// of the zone there can import time/tzdata.
+var Kyiv = loadKyiv()
+
+// kyivRule is the POSIX TZ rule of Kyiv time: +02:00, +03:00 from the
+// last Sunday of March 03:00 till the last Sunday of October 04:00
+const kyivRule = "EET-2EEST,M3.5.0/3,M10.5.0/4"
+
+func loadKyiv() *time.Location {
+	for _, name := range []string{"Europe/Kyiv", "Europe/Kiev"} {
+		if location, err := time.LoadLocation(name); err == nil {
+			return location
+		}
+	}
+
+	return kyivFallback()
+}
+
+// kyivFallback
+// Builds the zone from kyivRule, as a TZif file with no transitions
+// and the rule in its footer
+func kyivFallback() *time.Location {
+	header := func(typecnt, charcnt uint32) []byte {
+		data := append([]byte("TZif2"), make([]byte, 15+6*4)...)
+		binary.BigEndian.PutUint32(data[20+4*4:], typecnt)
+		binary.BigEndian.PutUint32(data[20+5*4:], charcnt)
+
+		return data
+	}
+
+	// one local time type: +02:00, standard time, abbreviation "EET"
+	zone := []byte{0, 0, 0x1c, 0x20, 0, 0, 'E', 'E', 'T', 0}
+
+	var data []byte
+
+	data = append(data, header(1, 4)...)
+	data = append(data, zone...)
+	data = append(data, header(1, 4)...)
+	data = append(data, zone...)
+	data = append(data, "\n"+kyivRule+"\n"...)
+
+	location, err := time.LoadLocationFromTZData("EET", data)
+
+	if err != nil {
+		return time.FixedZone("EET", 2*60*60)
+	}
+
+	return location
+}
+
+const (
+	dateLayout     = "2006-01-02"
+	dateTimeLayout = "2006-01-02 15:04:05"
+)
+
+// dateTimeLayouts are the formats Opendatabot uses for dates and times
+var dateTimeLayouts = []struct {
+	layout string
+	zoned  bool // layout carries an offset
+}{
+	{time.RFC3339Nano, true},
+	{"2006-01-02 15:04:05Z07:00", true},
+	{"2006-01-02 15:04:05-07", true},
+	{"2006-01-02 15:04:05-0700", true},
+	{"2006-01-02T15:04:05-07", true},
+	{"2006-01-02T15:04:05", false},
+	{dateTimeLayout, false},
+	{"2006-01-02 15:04", false},
+	{dateLayout, false},
+	{"02.01.2006 15:04:05", false},
+	{"02.01.2006 15:04", false},
+	{"02.01.2006", false},
+}
+
+// ParseDateTime
+// Parses any date or datetime format emitted by the API.
+// Values without an offset are taken in Kyiv time.
+// Empty values, "null" and zero dates give the zero time.
+func ParseDateTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	if value == "" || strings.EqualFold(value, "null") || strings.HasPrefix(value, "0000-00-00") {
+		return time.Time{}, nil
+	}
+
+	for _, format := range dateTimeLayouts {
+		var (
+			parsed time.Time
+			err    error
+		)
+
+		if format.zoned {
+			parsed, err = time.Parse(format.layout, value)
+		} else {
+			parsed, err = time.ParseInLocation(format.layout, value, Kyiv)
+		}
+
+		if err == nil {
+			return parsed, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("odb: cannot parse date %q", value)
+}
+
+// ParseDate
+// Parses any date or datetime format emitted by the API
+// and keeps the calendar day in Kyiv time
+func ParseDate(value string) (time.Time, error) {
+	parsed, err := ParseDateTime(value)
+
+	if err != nil || parsed.IsZero() {
+		return parsed, err
+	}
+
+	year, month, day := parsed.In(Kyiv).Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, Kyiv), nil
+}
+
+// kyivDate
+// Calendar day of the moment in Kyiv time as "2006-01-02", empty for the zero time
+func kyivDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.In(Kyiv).Format(dateLayout)
+}
+
+// unmarshalTime
+// Time from a JSON string; null, "" and "null" give the zero time
+func unmarshalTime(data []byte, parse func(string) (time.Time, error)) (time.Time, error) {
+	if string(data) == "null" {
+		return time.Time{}, nil
+	}
+
+	var value string
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return time.Time{}, fmt.Errorf("odb: cannot decode %s as date", data)
+	}
+
+	return parse(value)
+}
+
+// Date is a calendar day, encoded as "2006-01-02".
+// The zero value is encoded as null.
+type Date struct {
+	time.Time
+}
+
+func (d *Date) UnmarshalJSON(data []byte) (err error) {
+	d.Time, err = unmarshalTime(data, ParseDate)
+
+	return err
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(d.Format(dateLayout))
+}
+
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+
+	return d.Format(dateLayout)
+}
+
+// DateTime is a moment in time, encoded as RFC 3339.
+// The zero value is encoded as null.
+type DateTime struct {
+	time.Time
+}
+
+func (d *DateTime) UnmarshalJSON(data []byte) (err error) {
+	d.Time, err = unmarshalTime(data, ParseDateTime)
+
+	return err
+}
+
+func (d DateTime) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(d.Format(time.RFC3339))
+}
+
+func (d DateTime) String() string {
+	if d.IsZero() {
+		return ""
+	}
+
+	return d.In(Kyiv).Format(dateTimeLayout)
+}
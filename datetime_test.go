@@ -0,0 +1,80 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDateUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data string
+		want time.Time
+	}{
+		{`null`, time.Time{}},
+		{`""`, time.Time{}},
+		{`"null"`, time.Time{}},
+		{`"NULL"`, time.Time{}},
+		{`"0000-00-00"`, time.Time{}},
+		{`"2019-12-31"`, time.Date(2019, 12, 31, 0, 0, 0, 0, Kyiv)},
+		{`"31.12.2019"`, time.Date(2019, 12, 31, 0, 0, 0, 0, Kyiv)},
+		{`"2019-12-31 23:30:00+00"`, time.Date(2020, 1, 1, 0, 0, 0, 0, Kyiv)},
+	}
+
+	for _, test := range tests {
+		var date Date
+
+		if err := json.Unmarshal([]byte(test.data), &date); err != nil {
+			t.Errorf("%s: %v", test.data, err)
+			continue
+		}
+
+		if !date.Equal(test.want) {
+			t.Errorf("%s = %v, want %v", test.data, date.Time, test.want)
+		}
+	}
+}
+
+func TestFullCompanySingletaxNullDateEnd(t *testing.T) {
+	var company Fullcompany
+
+	data := `{"warnings":[{"singletax":{"date_start":"2019-01-01","date_end":"null"}}]}`
+
+	if err := json.Unmarshal([]byte(data), &company); err != nil {
+		t.Fatal(err)
+	}
+
+	if singletax := company.Warnings[0].Singletax; singletax == nil || !singletax.DateEnd.IsZero() || singletax.DateStart.IsZero() {
+		t.Errorf("singletax = %+v, want only date_start", singletax)
+	}
+}
+
+func TestKyivFallback(t *testing.T) {
+	location := kyivFallback()
+
+	tests := []struct {
+		at     time.Time
+		offset int
+	}{
+		{time.Date(2022, 1, 15, 12, 0, 0, 0, time.UTC), 2 * 60 * 60},
+		{time.Date(2022, 7, 15, 12, 0, 0, 0, time.UTC), 3 * 60 * 60},
+		{time.Date(2022, 3, 27, 0, 59, 0, 0, time.UTC), 2 * 60 * 60},
+		{time.Date(2022, 3, 27, 1, 0, 0, 0, time.UTC), 3 * 60 * 60},
+		{time.Date(2022, 10, 30, 0, 59, 0, 0, time.UTC), 3 * 60 * 60},
+		{time.Date(2022, 10, 30, 1, 0, 0, 0, time.UTC), 2 * 60 * 60},
+	}
+
+	for _, test := range tests {
+		if _, offset := test.at.In(location).Zone(); offset != test.offset {
+			t.Errorf("%v: offset %d, want %d", test.at, offset, test.offset)
+		}
+
+		if _, offset := test.at.In(Kyiv).Zone(); offset != test.offset {
+			t.Errorf("Kyiv %v: offset %d, want %d", test.at, offset, test.offset)
+		}
+	}
+}
@@ -0,0 +1,353 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ReportSource is a data source of CounterpartyReport
+type ReportSource string
+
+const (
+	SourceCompany           ReportSource = "company"            // GetCompany
+	SourceGovernmentCompany ReportSource = "government_company" // GetGovernmentCompany
+	SourceVat               ReportSource = "vat"                // GetVat
+	SourceSingletax         ReportSource = "singletax"          // GetSingletax
+	SourceWagedebt          ReportSource = "wagedebt"           // GetWagedebt
+	SourceInspections       ReportSource = "inspections"        // GetInspections
+	SourceAudit             ReportSource = "audit"              // GetAudit
+	SourcePermits           ReportSource = "permits"            // GetPermits
+	SourceCourts            ReportSource = "courts"             // GetCompanyCourts
+	SourcePenalties         ReportSource = "penalties"          // GetPenaltiesByCode
+	SourceRealty            ReportSource = "realty"             // GetRealty
+)
+
+// ReportSources is the order of sections in CounterpartyReport
+var ReportSources = []ReportSource{
+	SourceCompany,
+	SourceGovernmentCompany,
+	SourceVat,
+	SourceSingletax,
+	SourceWagedebt,
+	SourceInspections,
+	SourceAudit,
+	SourcePermits,
+	SourceCourts,
+	SourcePenalties,
+	SourceRealty,
+}
+
+// ReportSection is the outcome of one source of CounterpartyReport
+type ReportSection struct {
+	Source       ReportSource // Джерело
+	Found        bool         // Джерело повернуло запис
+	Err          error        // Помилка запиту, nil якщо дані отримано
+	DatabaseDate *DateTime    // Дата актуальності даних, nil якщо джерело її не повідомляє
+}
+
+// RedFlag is a stop factor found in CounterpartyReport
+type RedFlag struct {
+	Source ReportSource // Джерело
+	Code   string       // Машиночитний код, наприклад "wagedebt"
+	Text   string       // Опис
+}
+
+// CounterpartyReport is the outcome of DueDiligence.
+// Data of a source is nil when its request failed or found nothing,
+// see Sections for the reason.
+type CounterpartyReport struct {
+	Code              EDRPOU               // Код ЄДРПОУ
+	Company           *CompanyData         // Реєстраційні дані
+	GovernmentCompany *GovernmentCompany   // Належність державі
+	Vat               *Vat                 // Реєстрація платником ПДВ
+	Singletax         *SingletaxSuccess    // Єдиний податок
+	Wagedebt          *Wagedebt            // Заборгованість із заробітної плати
+	Inspections       *InspectionsResponse // Перевірки
+	Audits            []AuditsData         // Аудити
+	Permits           *LicensesData        // Ліцензії
+	Courts            *CompanyCourtsList   // Судові справи
+	Penalties         *PenaltiesSuccess    // Виконавчі провадження
+	Realty            *RealtySuccess       // Нерухомість
+	Sections          []ReportSection      // Стан джерел у порядку ReportSources
+	RedFlags          []RedFlag            // Стоп-фактори
+}
+
+// Section
+// State of the source, nil for an unknown source
+func (r *CounterpartyReport) Section(source ReportSource) *ReportSection {
+	for i := range r.Sections {
+		if r.Sections[i].Source == source {
+			return &r.Sections[i]
+		}
+	}
+
+	return nil
+}
+
+// Failed
+// Sections whose requests failed, the report is incomplete without them
+func (r *CounterpartyReport) Failed() (sections []ReportSection) {
+	for _, section := range r.Sections {
+		if section.Err != nil {
+			sections = append(sections, section)
+		}
+	}
+
+	return sections
+}
+
+// IsComplete
+// Reports whether every source was requested successfully
+func (r *CounterpartyReport) IsComplete() bool {
+	return len(r.Failed()) == 0
+}
+
+// DueDiligence
+// Requests all sources about the company in parallel and joins them into one report.
+// A failed source is recorded in its section and does not fail the report;
+// an error is returned only for an invalid code or a missing API key.
+func (odb *OdbClient) DueDiligence(ctx context.Context, code string) (*CounterpartyReport, error) {
+	parsed, err := normalizeEdrpou(code)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkApiKey(odb); err != nil {
+		return nil, err
+	}
+
+	client := odb.WithContext(ctx)
+	report := &CounterpartyReport{
+		Code:     EDRPOU(parsed),
+		Sections: make([]ReportSection, len(ReportSources)),
+	}
+
+	fetchers := report.fetchers(client)
+
+	var waitGroup sync.WaitGroup
+
+	for i, source := range ReportSources {
+		i, source := i, source
+
+		waitGroup.Add(1)
+
+		go func() {
+			defer waitGroup.Done()
+
+			section := ReportSection{Source: source}
+			date, found, err := fetchers[source]()
+			section.Found, section.Err = found, err
+
+			if !date.IsZero() {
+				section.DatabaseDate = &date
+			}
+
+			var apiErr *ApiError
+
+			if errors.As(section.Err, &apiErr) && apiErr.IsNotFound() {
+				section.Err = nil
+			}
+
+			report.Sections[i] = section
+		}()
+	}
+
+	waitGroup.Wait()
+
+	report.RedFlags = report.redFlags()
+
+	return report, nil
+}
+
+// fetchers
+// Requests of every source. Each one fills only its own field of the report,
+// so they may run concurrently.
+func (r *CounterpartyReport) fetchers(client *OdbClient) map[ReportSource]func() (DateTime, bool, error) {
+	code := string(r.Code)
+
+	return map[ReportSource]func() (DateTime, bool, error){
+		SourceCompany: func() (DateTime, bool, error) {
+			companies, err := client.GetCompany(code)
+
+			if err != nil || len(companies) == 0 {
+				return DateTime{}, false, err
+			}
+
+			r.Company = &companies[0]
+
+			return r.Company.DatabaseDate, true, nil
+		},
+		SourceGovernmentCompany: func() (DateTime, bool, error) {
+			response, err := client.GetGovernmentCompany(code)
+
+			if err != nil {
+				return DateTime{}, false, err
+			}
+
+			r.GovernmentCompany = response
+
+			return DateTime{}, response.Data.Count > 0, nil
+		},
+		SourceVat: func() (DateTime, bool, error) {
+			response, err := client.GetVat(map[string]string{"companyCode": code})
+
+			if err != nil {
+				return DateTime{}, false, err
+			}
+
+			r.Vat = response
+
+			return DateTime{response.Data.DatabaseDate.Time}, response.Data.PdvCode != "", nil
+		},
+		SourceSingletax: func() (DateTime, bool, error) {
+			response, err := client.GetSingletax(map[string]string{"code": code})
+
+			if err != nil {
+				return DateTime{}, false, err
+			}
+
+			r.Singletax = response
+
+			return DateTime{}, len(response.Data.Items) > 0, nil
+		},
+		SourceWagedebt: func() (DateTime, bool, error) {
+			response, err := client.GetWagedebt(code)
+
+			if err != nil {
+				return DateTime{}, false, err
+			}
+
+			r.Wagedebt = response
+
+			return DateTime{response.DatabaseDate.Time}, response.Code != "", nil
+		},
+		SourceInspections: func() (DateTime, bool, error) {
+			response, err := client.GetInspections(code)
+
+			if err != nil {
+				return DateTime{}, false, err
+			}
+
+			r.Inspections = response
+
+			// Inspections carry the date each one was added, the latest is the freshness
+			var latest DateTime
+
+			for _, item := range response.Data.Items {
+				if item.DatabaseDate.After(latest.Time) {
+					latest = DateTime{item.DatabaseDate.Time}
+				}
+			}
+
+			return latest, len(response.Data.Items) > 0, nil
+		},
+		SourceAudit: func() (DateTime, bool, error) {
+			response, err := client.GetAudit(map[string]string{"code": code})
+
+			if err != nil {
+				return DateTime{}, false, err
+			}
+
+			r.Audits = response
+
+			return DateTime{}, len(response) > 0, nil
+		},
+		SourcePermits: func() (DateTime, bool, error) {
+			response, err := client.GetPermits(map[string]string{"code": code})
+
+			if err != nil {
+				return DateTime{}, false, err
+			}
+
+			r.Permits = response
+
+			return DateTime{}, len(response.Data.Items) > 0, nil
+		},
+		SourceCourts: func() (DateTime, bool, error) {
+			response, err := client.GetCompanyCourts(code)
+
+			if err != nil {
+				return DateTime{}, false, err
+			}
+
+			r.Courts = response
+
+			return DateTime{}, true, nil
+		},
+		SourcePenalties: func() (DateTime, bool, error) {
+			response, err := client.GetPenaltiesByCode(code, map[string]string{})
+
+			if err != nil {
+				return DateTime{}, false, err
+			}
+
+			r.Penalties = response
+
+			return DateTime{}, response.Data.Count > 0, nil
+		},
+		SourceRealty: func() (DateTime, bool, error) {
+			response, err := client.GetRealty(code, map[string]string{})
+
+			if err != nil {
+				return DateTime{}, false, err
+			}
+
+			r.Realty = response
+
+			return DateTime{}, len(response.Data.Items) > 0, nil
+		},
+	}
+}
+
+// redFlags
+// Stop factors found in the fetched sections
+func (r *CounterpartyReport) redFlags() (flags []RedFlag) {
+	add := func(source ReportSource, code, text string) {
+		flags = append(flags, RedFlag{Source: source, Code: code, Text: text})
+	}
+
+	if section := r.Section(SourceCompany); section.Err == nil && r.Company == nil {
+		add(SourceCompany, "not_found", "Компанію не знайдено в реєстрі")
+	}
+
+	if r.Company != nil && r.Company.Status != "" && r.Company.Status != "зареєстровано" {
+		add(SourceCompany, "status", fmt.Sprintf("Статус компанії: %s", r.Company.Status))
+	}
+
+	if r.Vat != nil && !r.Vat.Data.DateAnul.IsZero() {
+		add(SourceVat, "vat_annulled", fmt.Sprintf("Реєстрацію платника ПДВ анульовано %s", r.Vat.Data.DateAnul))
+	}
+
+	if r.Wagedebt != nil && r.Wagedebt.Debt > 0 {
+		add(SourceWagedebt, "wagedebt", fmt.Sprintf("Заборгованість із заробітної плати: %s грн", r.Wagedebt.Debt))
+	}
+
+	if r.Inspections != nil {
+		var violations int
+
+		for _, item := range r.Inspections.Data.Items {
+			violations += item.ViolationsCount.Int()
+		}
+
+		if violations > 0 {
+			add(SourceInspections, "violations", fmt.Sprintf("Порушення за результатами перевірок: %d", violations))
+		}
+	}
+
+	if r.Courts != nil && r.Courts.Criminal.Count > 0 {
+		add(SourceCourts, "criminal_cases", fmt.Sprintf("Кримінальні справи: %d", r.Courts.Criminal.Count))
+	}
+
+	if r.Penalties != nil && r.Penalties.Data.Count > 0 {
+		add(SourcePenalties, "penalties", fmt.Sprintf("Відкриті виконавчі провадження: %d", r.Penalties.Data.Count))
+	}
+
+	return flags
+}
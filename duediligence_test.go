@@ -0,0 +1,115 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDueDiligence(t *testing.T) {
+	var (
+		mutex    sync.Mutex
+		requests = map[string]int{}
+	)
+
+	client := newTestClient(t, func(req *http.Request) (int, interface{}) {
+		mutex.Lock()
+		requests[req.URL.Path]++
+		mutex.Unlock()
+
+		// keep every source in flight together
+		time.Sleep(time.Millisecond)
+
+		switch req.URL.Path {
+		case "/api/v2/company/41711425":
+			return http.StatusOK, json.RawMessage(`[{"code":"41711425","status":"в стані припинення","database_date":"2022-05-01 10:00:00"}]`)
+		case "/api/v2/government-companies":
+			return http.StatusInternalServerError, nil
+		case "/api/v2/vat":
+			return http.StatusNotFound, nil
+		case "/api/v2/wagedebt/41711425":
+			return http.StatusOK, json.RawMessage(`{"code":"41711425","debt":"13682.43","database_date":"2022-04-01"}`)
+		case "/api/v2/inspections":
+			return http.StatusOK, json.RawMessage(`{"data":{"items":[
+				{"database_date":"2021-02-01","violations_count":"2"},
+				{"database_date":"2022-03-01"},
+				{}]}}`)
+		case "/api/v2/audit":
+			return http.StatusOK, json.RawMessage(`[]`)
+		}
+
+		return http.StatusOK, json.RawMessage(`{}`)
+	})
+
+	report, err := client.DueDiligence(context.Background(), "4171 1425")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requests) != len(ReportSources) {
+		t.Errorf("requests %v", requests)
+	}
+
+	for i, section := range report.Sections {
+		if section.Source != ReportSources[i] {
+			t.Errorf("section %d is %s, want %s", i, section.Source, ReportSources[i])
+		}
+	}
+
+	dates := map[ReportSource]time.Time{
+		SourceCompany:     time.Date(2022, 5, 1, 10, 0, 0, 0, Kyiv),
+		SourceWagedebt:    time.Date(2022, 4, 1, 0, 0, 0, 0, Kyiv),
+		SourceInspections: time.Date(2022, 3, 1, 0, 0, 0, 0, Kyiv),
+	}
+
+	for _, section := range report.Sections {
+		want, ok := dates[section.Source]
+
+		if !ok {
+			if section.DatabaseDate != nil {
+				t.Errorf("%s: date %v", section.Source, section.DatabaseDate)
+			}
+
+			continue
+		}
+
+		if section.DatabaseDate == nil || !section.DatabaseDate.Equal(want) {
+			t.Errorf("%s: date %v, want %v", section.Source, section.DatabaseDate, want)
+		}
+	}
+
+	if vat := report.Section(SourceVat); vat.Found || vat.Err != nil || report.Vat != nil {
+		t.Errorf("vat section %+v", vat)
+	}
+
+	if failed := report.Failed(); len(failed) != 1 || failed[0].Source != SourceGovernmentCompany || report.IsComplete() {
+		t.Errorf("failed %+v", failed)
+	}
+
+	if report.Company == nil || report.Wagedebt == nil || report.Inspections == nil || !report.Section(SourceCourts).Found {
+		t.Errorf("report %+v", report)
+	}
+
+	var flags []string
+
+	for _, flag := range report.RedFlags {
+		flags = append(flags, flag.Code)
+	}
+
+	if want := []string{"status", "wagedebt", "violations"}; !reflect.DeepEqual(flags, want) {
+		t.Errorf("red flags %v, want %v", flags, want)
+	}
+
+	if _, err = client.DueDiligence(context.Background(), "41711426"); err == nil {
+		t.Error("invalid code was accepted")
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// unquote
+// Raw JSON value without quotes, empty for null and ""
+func unquote(data []byte) (string, error) {
+	data = bytes.TrimSpace(data)
+
+	if bytes.Equal(data, []byte("null")) {
+		return "", nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var value string
+
+		if err := json.Unmarshal(data, &value); err != nil {
+			return "", err
+		}
+
+		return strings.TrimSpace(value), nil
+	}
+
+	return string(data), nil
+}
+
+// trimDecorations
+// Value without trailing emoji variation selectors, keycaps and symbols,
+// e.g. "2️" as sent in the wagedebt example
+func trimDecorations(value string) string {
+	return strings.TrimRightFunc(value, func(r rune) bool {
+		return unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf, unicode.So)
+	})
+}
+
+// FlexInt is an integer encoded by the API either as a number or as a string.
+// null and "" decode to zero, trailing emoji decorations of a string are ignored.
+type FlexInt int64
+
+func (i *FlexInt) UnmarshalJSON(data []byte) error {
+	value, err := unquote(data)
+
+	if err != nil {
+		return err
+	}
+
+	value = trimDecorations(value)
+
+	if value == "" {
+		*i = 0
+
+		return nil
+	}
+
+	if parsed, err := strconv.ParseInt(value, 10, 64); err == nil {
+		*i = FlexInt(parsed)
+
+		return nil
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+
+	if err != nil || parsed != math.Trunc(parsed) {
+		return fmt.Errorf("odb: cannot decode %s as integer", data)
+	}
+
+	*i = FlexInt(parsed)
+
+	return nil
+}
+
+func (i FlexInt) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(int64(i), 10)), nil
+}
+
+// Int
+// Value as int
+func (i FlexInt) Int() int {
+	return int(i)
+}
+
+func (i FlexInt) String() string {
+	return strconv.FormatInt(int64(i), 10)
+}
+
+// FlexString is a text encoded by the API either as a string or as a number.
+// null decodes to an empty string, surrounding spaces are trimmed.
+type FlexString string
+
+func (s *FlexString) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		return fmt.Errorf("odb: cannot decode %s as string", data)
+	}
+
+	value, err := unquote(data)
+
+	if err != nil {
+		return err
+	}
+
+	*s = FlexString(value)
+
+	return nil
+}
+
+func (s FlexString) String() string {
+	return string(s)
+}
+
+// FlexBool is a flag encoded by the API as a boolean, a number or a string:
+// true/false, 1/0, "1"/"0", "true"/"false". null and "" decode to false.
+type FlexBool bool
+
+func (b *FlexBool) UnmarshalJSON(data []byte) error {
+	value, err := unquote(data)
+
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(value) {
+	case "", "0", "false", "no", "n":
+		*b = false
+	case "1", "true", "yes", "y":
+		*b = true
+	default:
+		return fmt.Errorf("odb: cannot decode %s as boolean", data)
+	}
+
+	return nil
+}
+
+func (b FlexBool) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatBool(bool(b))), nil
+}
+
+// Bool
+// Value as bool
+func (b FlexBool) Bool() bool {
+	return bool(b)
+}
+
+// Money is an amount in hryvnias stored as kopiykas (minor units),
+// so decimal amounts are not distorted by floating point.
+// The API sends it as a number or a string, e.g. 3861, "3861.00", "37 334,5".
+type Money int64
+
+// ParseMoney
+// Parses a decimal amount with up to two fraction digits;
+// spaces are ignored and a comma may be used as the decimal separator
+func ParseMoney(value string) (Money, error) {
+	cleaned := strings.NewReplacer(" ", "", " ", "", ",", ".").Replace(strings.TrimSpace(value))
+
+	if cleaned == "" {
+		return 0, nil
+	}
+
+	negative := false
+
+	switch cleaned[0] {
+	case '-':
+		negative = true
+		cleaned = cleaned[1:]
+	case '+':
+		cleaned = cleaned[1:]
+	}
+
+	units, fraction := cleaned, ""
+
+	if dot := strings.IndexByte(cleaned, '.'); dot >= 0 {
+		units, fraction = cleaned[:dot], cleaned[dot+1:]
+	}
+
+	if units == "" {
+		units = "0"
+	}
+
+	if !isDigits(units) || !isDigits(fraction) {
+		return 0, fmt.Errorf("odb: cannot parse money %q", value)
+	}
+
+	// Amounts are rounded half up to whole kopiykas
+	roundUp := len(fraction) > 2 && fraction[2] >= '5'
+
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+
+	amount, err := strconv.ParseInt(units+fraction[:2], 10, 64)
+
+	if err != nil {
+		return 0, fmt.Errorf("odb: cannot parse money %q", value)
+	}
+
+	if roundUp {
+		amount++
+	}
+
+	if negative {
+		amount = -amount
+	}
+
+	return Money(amount), nil
+}
+
+func isDigits(value string) bool {
+	for _, char := range value {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	value, err := unquote(data)
+
+	if err != nil {
+		return err
+	}
+
+	// Numbers in exponent form, e.g. 1.5e+06
+	if strings.ContainsAny(value, "eE") {
+		parsed, err := strconv.ParseFloat(value, 64)
+
+		if err != nil {
+			return fmt.Errorf("odb: cannot decode %s as money", data)
+		}
+
+		*m = Money(math.Round(parsed * 100))
+
+		return nil
+	}
+
+	parsed, err := ParseMoney(value)
+
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+
+	return nil
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// Kopiykas
+// Amount in minor units
+func (m Money) Kopiykas() int64 {
+	return int64(m)
+}
+
+// Float64
+// Amount in hryvnias, for display and approximate arithmetic only
+func (m Money) Float64() float64 {
+	return float64(m) / 100
+}
+
+// String
+// Decimal amount with two fraction digits, e.g. "3861.00"
+func (m Money) String() string {
+	sign := ""
+	amount := int64(m)
+
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, amount/100, amount%100)
+}
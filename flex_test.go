@@ -0,0 +1,191 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFlexInt(t *testing.T) {
+	tests := []struct {
+		data string
+		want FlexInt
+		fail bool
+	}{
+		{data: `12`, want: 12},
+		{data: `"12"`, want: 12},
+		{data: `" 12 "`, want: 12},
+		{data: `-3`, want: -3},
+		{data: `12.0`, want: 12},
+		{data: `"2️"`, want: 2},
+		{data: `"7⃣"`, want: 7},
+		{data: `null`, want: 0},
+		{data: `""`, want: 0},
+		{data: `12.5`, fail: true},
+		{data: `"twelve"`, fail: true},
+		{data: `"2a"`, fail: true},
+		{data: `true`, fail: true},
+	}
+
+	for _, test := range tests {
+		value := FlexInt(99)
+		err := json.Unmarshal([]byte(test.data), &value)
+
+		if test.fail {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %d", test.data, value)
+			}
+
+			continue
+		}
+
+		if err != nil || value != test.want {
+			t.Errorf("%s: got %d, %v, want %d", test.data, value, err, test.want)
+		}
+	}
+
+	encoded, err := json.Marshal(FlexInt(42))
+
+	if err != nil || string(encoded) != "42" {
+		t.Errorf("marshal: %s, %v", encoded, err)
+	}
+}
+
+func TestFlexString(t *testing.T) {
+	tests := []struct {
+		data string
+		want FlexString
+		fail bool
+	}{
+		{data: `"100198"`, want: "100198"},
+		{data: `100198`, want: "100198"},
+		{data: `" 0012 "`, want: "0012"},
+		{data: `12.50`, want: "12.50"},
+		{data: `null`, want: ""},
+		{data: `""`, want: ""},
+		{data: `{}`, fail: true},
+		{data: `["1"]`, fail: true},
+	}
+
+	for _, test := range tests {
+		var value FlexString
+		err := json.Unmarshal([]byte(test.data), &value)
+
+		if test.fail {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %q", test.data, value)
+			}
+
+			continue
+		}
+
+		if err != nil || value != test.want {
+			t.Errorf("%s: got %q, %v, want %q", test.data, value, err, test.want)
+		}
+	}
+}
+
+func TestFlexBool(t *testing.T) {
+	tests := []struct {
+		data string
+		want FlexBool
+		fail bool
+	}{
+		{data: `true`, want: true},
+		{data: `false`, want: false},
+		{data: `1`, want: true},
+		{data: `0`, want: false},
+		{data: `"1"`, want: true},
+		{data: `"0"`, want: false},
+		{data: `"true"`, want: true},
+		{data: `"False"`, want: false},
+		{data: `null`, want: false},
+		{data: `""`, want: false},
+		{data: `2`, fail: true},
+		{data: `"maybe"`, fail: true},
+	}
+
+	for _, test := range tests {
+		value := FlexBool(!test.want)
+		err := json.Unmarshal([]byte(test.data), &value)
+
+		if test.fail {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %v", test.data, value)
+			}
+
+			continue
+		}
+
+		if err != nil || value != test.want {
+			t.Errorf("%s: got %v, %v, want %v", test.data, value, err, test.want)
+		}
+	}
+}
+
+func TestMoney(t *testing.T) {
+	tests := []struct {
+		data string
+		want Money
+		fail bool
+	}{
+		{data: `3861`, want: 386100},
+		{data: `"3861.00"`, want: 386100},
+		{data: `"37 334,5"`, want: 3733450},
+		{data: `"0.1"`, want: 10},
+		{data: `".5"`, want: 50},
+		{data: `"1.005"`, want: 101},
+		{data: `"1.004"`, want: 100},
+		{data: `"-1.005"`, want: -101},
+		{data: `13682.43`, want: 1368243},
+		{data: `1.5e+06`, want: 150000000},
+		{data: `null`, want: 0},
+		{data: `""`, want: 0},
+		{data: `"12 грн"`, fail: true},
+		{data: `"1.2.3"`, fail: true},
+	}
+
+	for _, test := range tests {
+		var value Money
+		err := json.Unmarshal([]byte(test.data), &value)
+
+		if test.fail {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %s", test.data, value)
+			}
+
+			continue
+		}
+
+		if err != nil || value != test.want {
+			t.Errorf("%s: got %d, %v, want %d", test.data, value, err, test.want)
+		}
+	}
+
+	if value := Money(-105); value.String() != "-1.05" || value.Kopiykas() != -105 || value.Float64() != -1.05 {
+		t.Errorf("money %d: %s", value, value)
+	}
+
+	encoded, err := json.Marshal(Money(386100))
+
+	if err != nil || string(encoded) != "3861.00" {
+		t.Errorf("marshal: %s, %v", encoded, err)
+	}
+}
+
+func TestWagedebtDocumentedExample(t *testing.T) {
+	var wagedebt Wagedebt
+
+	data := `{"code":"11111111","debt":"13682.43","penalties_count":"2️","name":"ПАТ","database_date":"2018-05-25","active":1}`
+
+	if err := json.Unmarshal([]byte(data), &wagedebt); err != nil {
+		t.Fatal(err)
+	}
+
+	if wagedebt.PenaltiesCount != 2 || wagedebt.Debt != 1368243 || wagedebt.DatabaseDate.Year() != 2018 {
+		t.Errorf("wagedebt %+v", wagedebt)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"errors"
+)
+
+type ActivityKind struct {
+	Name      string `json:"name"` // Назва виду діяльності
+	Code      string `json:"code"` // Код виду діяльності
+	IsPrimary bool   `json:"is_primary"`
+}
+
+// PrimaryActivity
+// Main activity kind of the FOP, nil when not specified
+func (f *FopDpa) PrimaryActivity() *ActivityKind {
+	for i := range f.ActivityKinds {
+		if f.ActivityKinds[i].IsPrimary {
+			return &f.ActivityKinds[i]
+		}
+	}
+
+	return nil
+}
+
+type FopStatus struct {
+	Status     bool   `json:"status"`      // Статус перевірки
+	StatusName string `json:"status_name"` // Статус ФОП
+}
+
+// IsRegistered
+// Reports whether the FOP was found and is registered
+func (s *FopStatus) IsRegistered() bool {
+	return s.Status && s.StatusName == "зареєстровано"
+}
+
+// CheckFopStatus
+// Швидка перевірка статусу ФОП за кодом (ІПН).
+// Використовує /dpa/{code}; якщо ФОП не знайдено, Status дорівнює false
+func (odb *OdbClient) CheckFopStatus(
+	code string, // ІПН ФОПа
+) (response *FopStatus, err error) {
+	dpa, err := odb.GetDpa(code)
+
+	var apiErr *ApiError
+
+	if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+		return &FopStatus{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &FopStatus{Status: true, StatusName: dpa.Status}, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+const testFopDpa = `{
+	"code": "1234567899",
+	"full_name": "Петров Іван Володимирович",
+	"status": "зареєстровано",
+	"activity_kinds": [
+		{"name": "Видання комп'ютерних ігор", "code": "58.21", "is_primary": false},
+		{"name": "Комп'ютерне програмування", "code": "62.01", "is_primary": true}
+	],
+	"address": {"zip": "01034", "address": "м. Київ, вул. Ярославів Вал, 55", "parts": {"atu_code": "8038000000"}}
+}`
+
+func TestCheckFopStatus(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (int, interface{}) {
+		switch req.URL.Path {
+		case "/api/v2/dpa/1234567899":
+			return http.StatusOK, json.RawMessage(testFopDpa)
+		case "/api/v2/dpa/0000000000":
+			return http.StatusNotFound, map[string]string{"status": "error"}
+		}
+
+		t.Errorf("unexpected request %s", req.URL)
+
+		return http.StatusBadRequest, nil
+	})
+
+	status, err := client.CheckFopStatus(" 1234567899 ")
+
+	if err != nil || !status.IsRegistered() {
+		t.Errorf("status %+v, %v", status, err)
+	}
+
+	if status, err = client.CheckFopStatus("0000000000"); err != nil || status.Status || status.IsRegistered() {
+		t.Errorf("missing FOP status %+v, %v", status, err)
+	}
+
+	if _, err = client.CheckFopStatus("1234567890"); err == nil {
+		t.Error("invalid RNOKPP was sent")
+	}
+}
+
+func TestFopDpaModels(t *testing.T) {
+	var fop FopDpa
+
+	if err := json.Unmarshal([]byte(testFopDpa), &fop); err != nil {
+		t.Fatal(err)
+	}
+
+	if activity := fop.PrimaryActivity(); activity == nil || activity.Code != "62.01" {
+		t.Errorf("primary activity %+v", activity)
+	}
+
+	if fop.Address.Zip != "01034" || fop.Address.Parts.AtuCode != "8038000000" {
+		t.Errorf("address %+v", fop.Address)
+	}
+
+	if latin := fop.Latin(); latin.FullName == "" || latin.Location == "" {
+		t.Errorf("latin %+v", latin)
+	}
+
+	if (&FopDpa{}).PrimaryActivity() != nil {
+		t.Error("primary activity without activity kinds")
+	}
+}
@@ -0,0 +1,591 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+type Head struct {
+	Name string `json:"name"` // ПІБ
+	// керівник
+	// підписант
+	// голова комісії з припинення або ліквідатор
+	// комісія з припинення (комісія з реорганізації, ліквідаційна комісія)
+	// Розпорядник майна
+	// керуючий санацією
+	// Уповноважена особа Фонду гарантування вкладів фізичних осіб
+	// член комісії з виділу
+	// керівник комісії з виділу
+	Role        string `json:"role"`        // Роль учасника
+	Restriction string `json:"restriction"` // Обмеження повноважень
+}
+
+type Beneficiary struct {
+	Name     string `json:"name"`     // ПІБ
+	Role     string `json:"role"`     // Роль учасника
+	Amount   Money  `json:"amount"`   // Внесок учасника
+	Code     string `json:"code"`     // Код ЄДРПОУ учасника
+	Location string `json:"location"` // Адреса
+}
+
+type Activity struct {
+	Name      string `json:"name"`       // Види діяльності
+	IsPrimary bool   `json:"is_primary"` // Основний вид діяльності
+}
+
+type CompanyChange struct {
+	Date    Date `json:"date"` // Дата внесення змін
+	Changes []struct {
+		// назва
+		// адреса
+		// адреса в ЕДР
+		// керівник
+		// вид діяльності
+		// стан
+		// statute
+		// минулий власник
+		// новий власник
+		Field    string `json:"field"`     // Поле в якому відбулися зміни
+		OldValue string `json:"old_value"` // Старе значення
+		NewValue string `json:"new_value"` // Нове значення
+	} `json:"changes"` // Зміни
+}
+
+type CompanyRegistration struct {
+	EndDate     Date   `json:"end_date"`    // Дата зняття з обліку
+	Code        string `json:"code"`        // Ідентифікаційний код органу
+	Name        string `json:"name"`        // Назва органу
+	Description string `json:"description"` // Опис взяття на облік
+	Type        string `json:"type"`        // Тип взяття на облік
+	StartDate   Date   `json:"start_date"`  // Дата взяття на облік
+}
+
+// EdrAddress is an address block of the EDR.
+// An address sent as plain text is kept in Address,
+// a value that cannot be decoded is kept in Raw only.
+type EdrAddress struct {
+	Zip     string `json:"zip"`     // Поштовий індекс
+	Country string `json:"country"` // Країна
+	Address string `json:"address"` // Адреса
+	Parts   struct {
+		Atu       string `json:"atu"`        // Адміністративно-територіальна одиниця
+		AtuCode   string `json:"atu_code"`   // Код КОАТУУ
+		Street    string `json:"street"`     // Вулиця
+		HouseType string `json:"house_type"` // Тип будівлі
+		House     string `json:"house"`      // Номер будинку
+		Building  string `json:"building"`   // Номер корпусу
+		NumType   string `json:"num_type"`   // Тип приміщення
+		Num       string `json:"num"`        // Номер приміщення
+	} `json:"parts"` // Складові адреси
+
+	Raw json.RawMessage `json:"-"` // Адреса як отримано, якщо її не вдалося розібрати
+}
+
+func (a *EdrAddress) UnmarshalJSON(data []byte) error {
+	type plain EdrAddress
+
+	*a = EdrAddress{}
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '"' {
+		var address FlexString
+
+		if err := json.Unmarshal(trimmed, &address); err == nil {
+			a.Address = address.String()
+			return nil
+		}
+	}
+
+	var address plain
+
+	if err := json.Unmarshal(data, &address); err != nil {
+		a.Raw = append(json.RawMessage(nil), data...)
+		return nil
+	}
+
+	*a = EdrAddress(address)
+
+	return nil
+}
+
+// String
+// Full address text
+func (a EdrAddress) String() string {
+	return a.Address
+}
+
+type Branch struct {
+	Name     string     `json:"name"`      // Назва філії
+	Code     string     `json:"code"`      // Код ЄДРПОУ ВП
+	Address  EdrAddress `json:"address"`   // Адреса
+	Id       FlexInt    `json:"id"`        // Ідентифікатор філії
+	Type     string     `json:"type"`      // Тип відокремлення
+	TypeText string     `json:"type_text"` // Тип відокремлення
+}
+
+type TaxDebts struct {
+	Text         string `json:"text"`          // Текстова інформація
+	Icon         string `json:"icon"`          // ⚠️
+	Total        Money  `json:"total"`         // Загальний податковий борг
+	Local        Money  `json:"local"`         // Місцевий податковий борг
+	Government   Money  `json:"government"`    // Державний податковий борг
+	DatabaseDate Date   `json:"database_date"` // Дата актуальності
+	Type         string `json:"type"`          //
+}
+
+type Termination struct {
+	State        int    `json:"state"`
+	StateText    string `json:"state_text"`
+	Date         Date   `json:"date"`
+	RecordNumber string `json:"record_number"` // Номер реєстрації
+	Cause        string `json:"cause"`         // Причина припинення
+}
+
+type TerminationCancel struct {
+	Date         Date   `json:"date"`
+	RecordNumber string `json:"record_number"` // Номер реєстрації
+	DocDate      Date   `json:"doc_date"`
+	CourtName    string `json:"court_name"`
+	DocNumber    string `json:"doc_number"`
+	DateJudge    Date   `json:"date_judge"`
+}
+
+type Decision struct {
+	Number         string `json:"number"`          // Номер справи у реєстрі
+	Type           string `json:"type"`            // Форма судового рішення
+	Form           string `json:"form"`            // Форма судочинства
+	DocumentNumber string `json:"document_number"` // Номер справи
+	CourtName      string `json:"court_name"`      // Назва суду
+	EntryDate      Date   `json:"entry_date"`      // Дата набрання законної сили
+	Judge          string `json:"judge"`           // Суддя
+	Link           string `json:"link"`            // Посилання на рішення
+}
+
+type AmkDecision struct {
+	DecisionNumber string `json:"decision_number"` // Номер рішення
+	DecisionDate   Date   `json:"decision_date"`   // Дата рішення
+	Agency         string `json:"agency"`          // Виконавчий орган
+}
+
+type PenaltyListItem struct {
+	Number     string `json:"number"`     // Номер
+	CourtName  string `json:"court_name"` // Ким виданий документ
+	Category   string `json:"category"`   // Категорія
+	Department string `json:"department"` // Виконавець
+}
+
+type BancruptcyListItem struct {
+	Number string `json:"number"` // Номер
+	Court  string `json:"court"`  // Номер судової справи
+	Link   string `json:"link"`   // Посилання на судову справу
+	Date   Date   `json:"date"`   // Дата
+	Type   string `json:"type"`   // Тип
+}
+
+type Warning struct {
+	TaxDebts *TaxDebts `json:"tax_debts,omitempty"` // Податковий борг
+	Courts   *struct {
+		Text         string     `json:"text"` // Текстова інформація
+		Icon         string     `json:"icon"`
+		Value        int        `json:"value"`         // Кількість рішень
+		DatabaseDate Date       `json:"database_date"` // Дата актуальності
+		Decisions    []Decision `json:"decisions"`
+	} `json:"courts,omitempty"` // Судові рішення, в яких згадується компанія
+	MassAddress *struct {
+		Text  string `json:"text"` // Текстова інформація
+		Icon  string `json:"icon"`
+		Value string `json:"value"` // Кількість компаній за адресою
+	} `json:"mass_address,omitempty"` // Адреса масової реєстрації
+	Wagedebt *struct {
+		Debt           Money   `json:"debt"`            // Сумма заборгованості
+		PenaltiesCount FlexInt `json:"penalties_count"` // Кількість виконавчіх проваджень
+		DatabaseDate   Date    `json:"database_date"`   // Дата актуальності
+	} `json:"wagedebt,omitempty"` // Боржник по виплаті заробітної плати
+	Pdv *struct {
+		Text         string `json:"text"` // Текстова інформація
+		Icon         string `json:"icon"`
+		Number       string `json:"number"`        // Код ПДВ
+		Status       string `json:"status"`        // Статус платника
+		DatabaseDate Date   `json:"database_date"` // Дата актуальності
+	} `json:"pdv,omitempty"` // Платник ПДВ
+	Singletax *struct {
+		Status    string `json:"status"` // Стан єдиного податку
+		Text      string `json:"text"`   // Текстова інформація
+		Icon      string `json:"icon"`
+		Name      string `json:"name"`
+		FopHash   string `json:"fop_hash"`
+		DateStart Date   `json:"date_start"` // Дата відкриття єдиного податку
+		DateEnd   Date   `json:"date_end"`   // Дата закриття єдиного податку
+		Group     int    `json:"group"`      // Група податку
+		Rate      string `json:"rate"`       // Відсоткова ставка єдиного податку
+	} `json:"singletax,omitempty"` // Єдиний податок
+	SingletaxRisk *struct {
+		Text string `json:"text"` // Текстова інформація
+		Icon string `json:"icon"` // ⚠️
+	} `json:"singletax_risk,omitempty"` // Можлива втрата Єдиного податку
+	AmkList *struct {
+		Text         string        `json:"text"` // Текстова інформація
+		Icon         string        `json:"icon"`
+		Value        int           `json:"value"`         // Кількість рішень
+		DatabaseDate Date          `json:"database_date"` // Дата актуальності
+		List         []AmkDecision `json:"list"`          // Останні 5 рішень
+	} `json:"amk_list,omitempty"` // Спеціальні санкції АМКУ
+	Penalties *struct {
+		Text  string            `json:"text"` // Текстова інформація
+		Icon  string            `json:"icon"`
+		Value int               `json:"value"` // Кількість проваджень
+		List  []PenaltyListItem `json:"list"`  // Останні 5 проваджень
+	} `json:"penalties,omitempty"` // Поточні виконавчі провадження
+	Bancruptcy *struct {
+		Text  string               `json:"text"` // Текстова інформація
+		Icon  string               `json:"icon"`
+		Value int                  `json:"value"` // Кількість записів
+		List  []BancruptcyListItem `json:"list"`
+	} `json:"bancruptcy,omitempty"` // Компанія у процедурі ліквідації
+}
+
+type AuditCompany struct {
+	Date     Date   `json:"date"`     // Дата
+	Priority string `json:"priority"` // Пріоритет: незначний, середній, високий
+	Agency   string `json:"agency"`   // Контролюючий орган
+}
+
+type License struct {
+	Department string `json:"department"` // Орган, що видав ліцензію
+	Number     string `json:"number"`     // Номер ліцензії
+	StartDate  Date   `json:"start_date"` // Дата початку дії
+	EndDate    Date   `json:"end_date"`   // Дата закінчення дії
+	Active     int    `json:"active"`     // Статус
+}
+
+type Licenses struct {
+	WholesaleAlcohol []License `json:"wholesale-alcohol"` // Оптова торгівля алкоголем
+	WholesaleTobacco []License `json:"wholesale-tobacco"` // Оптова торгівля тютюном
+	WholesaleCider   []License `json:"wholesale-cider"`   // Оптова торгівля сидром
+	WholesaleBeer    []License `json:"wholesale-beer"`    // Оптова торгівля пивом
+}
+
+// PermitItem is a permit of a group: a fuel license or an excise warehouse.
+// The item is decoded by its type, or by the type of the group when it has none;
+// an item of another type or one that cannot be decoded is kept in Raw only.
+type PermitItem struct {
+	License *OilLicenseItem `json:"-"` // Ліцензія, для oil_license
+	Excise  *OilExciseItem  `json:"-"` // Акцизний склад, для oil_excise
+
+	Raw json.RawMessage `json:"-"` // Запис як отримано, якщо його не вдалося розібрати
+}
+
+func (i PermitItem) MarshalJSON() ([]byte, error) {
+	switch {
+	case i.License != nil:
+		return json.Marshal(i.License)
+	case i.Excise != nil:
+		return json.Marshal(i.Excise)
+	case len(i.Raw) > 0:
+		return i.Raw, nil
+	}
+
+	return []byte("null"), nil
+}
+
+// decodePermitItem
+// Typed permit item, groupType is used when the item has no type
+func decodePermitItem(raw json.RawMessage, groupType LicenseType) PermitItem {
+	var header struct {
+		Type LicenseType `json:"type"`
+	}
+
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return PermitItem{Raw: raw}
+	}
+
+	if header.Type == "" {
+		header.Type = groupType
+	}
+
+	switch header.Type {
+	case LicenseOilLicense:
+		var license OilLicenseItem
+
+		if err := json.Unmarshal(raw, &license); err == nil {
+			license.Type = header.Type
+			return PermitItem{License: &license}
+		}
+	case LicenseOilExcise:
+		var excise OilExciseItem
+
+		if err := json.Unmarshal(raw, &excise); err == nil {
+			excise.Type = header.Type
+			return PermitItem{Excise: &excise}
+		}
+	}
+
+	return PermitItem{Raw: raw}
+}
+
+type PermitGroup struct {
+	Icon    string       `json:"icon"`
+	Text    string       `json:"text"`    // Опис групи ліцензії
+	Count   FlexInt      `json:"count"`   // Кількість ліцензій у групі
+	Type    LicenseType  `json:"type"`    // oil_license, oil_excise
+	Subtype string       `json:"subtype"` // Пальне, Спирт, Роздрібна торгівля пальним, ...
+	Items   []PermitItem `json:"items"`
+}
+
+func (g *PermitGroup) UnmarshalJSON(data []byte) error {
+	type plain PermitGroup
+
+	var group struct {
+		plain
+		Items []json.RawMessage `json:"items"`
+	}
+
+	if err := json.Unmarshal(data, &group); err != nil {
+		return err
+	}
+
+	*g = PermitGroup(group.plain)
+	g.Items = nil
+
+	for _, raw := range group.Items {
+		g.Items = append(g.Items, decodePermitItem(raw, g.Type))
+	}
+
+	return nil
+}
+
+// EdrInfo is the additional information from the EDR.
+// The API does not describe the block, so the fields known from the EDR record
+// are decoded and the whole block is kept in Raw; a block that cannot be decoded
+// has Raw only.
+type EdrInfo struct {
+	Registration *struct {
+		Date         Date   `json:"date"`          // Дата реєстрації
+		RecordNumber string `json:"record_number"` // Номер реєстрації
+		RecordDate   Date   `json:"record_date"`   // Дата запису
+	} `json:"registration,omitempty"` // Дані про державну реєстрацію
+	Address           *EdrAddress           `json:"address,omitempty"`            // Блок з адресою
+	Registrations     []CompanyRegistration `json:"registrations,omitempty"`      // Дані реєстраторів
+	Termination       *Termination          `json:"termination,omitempty"`        // Статус припинення
+	TerminationCancel *TerminationCancel    `json:"termination_cancel,omitempty"` // Скасування припинення
+
+	Raw json.RawMessage `json:"-"` // Блок як отримано
+}
+
+func (e *EdrInfo) UnmarshalJSON(data []byte) error {
+	type plain EdrInfo
+
+	var info plain
+
+	raw := append(json.RawMessage(nil), data...)
+
+	if err := json.Unmarshal(data, &info); err != nil {
+		*e = EdrInfo{Raw: raw}
+		return nil
+	}
+
+	*e = EdrInfo(info)
+	e.Raw = raw
+
+	return nil
+}
+
+type Fullcompany struct {
+	FullName          string                `json:"full_name"`                    // Повна назва компанії
+	ShortName         string                `json:"short_name"`                   // Скорочена назва компанії
+	FullNameEn        string                `json:"full_name_en"`                 // Повна назва компанії англійською мовою
+	ShortNameEn       string                `json:"short_name_en"`                // Скорочена назва компанії англійською мовою
+	Code              string                `json:"code"`                         // Код ЄДРПОУ
+	Location          string                `json:"location"`                     // Адреса
+	CeoName           string                `json:"ceo_name"`                     // ПІБ
+	Status            string                `json:"status"`                       // зареєстровано, зареєстровано, свідоцтво про державну реєстрацію недійсне, порушено справу про банкрутство, порушено справу про банкрутство (санація), в стані припинення, припинено
+	Email             string                `json:"email"`                        // Електронна пошта
+	RegistrationDate  Date                  `json:"registration_date"`            // Дата реєстрації
+	Capital           Money                 `json:"capital"`                      // Капітал
+	Heads             []Head                `json:"heads"`                        // Керівники та підписанти
+	Activities        []Activity            `json:"activities"`                   // Види діяльності
+	LastTime          DateTime              `json:"last_time"`                    // Дата оновлення інформації
+	Phones            string                `json:"phones"`                       // Телефони
+	Beneficiaries     []Beneficiary         `json:"beneficiaries"`                // Засновники та бенефіціари
+	History           []CompanyChange       `json:"history"`                      // Історія змін
+	Registrations     []CompanyRegistration `json:"registrations"`                // Реєстраційна інформація
+	Branches          []Branch              `json:"branches"`                     // Інформація про філії
+	IsModalStatute    bool                  `json:"is_modal_statute"`             // Ознака наявності модального статута
+	Statute           string                `json:"statute"`                      // Код модального статута
+	Termination       *Termination          `json:"termination,omitempty"`        // Статус припинення
+	TerminationCancel *TerminationCancel    `json:"termination_cancel,omitempty"` // Скасування припинення
+	Warnings          []Warning             `json:"warnings"`                     // Стоп-фактори по компанії
+	Audits            []AuditCompany        `json:"audits"`                       // Планові перевірки
+	Licenses          *Licenses             `json:"licenses"`                     // Наявність спеціальних ліцензій
+	Permits           []PermitGroup         `json:"permits"`                      // Дозволи на роботу з пальним та спиртом
+	Edr               *EdrInfo              `json:"edr"`                          // Додаткова інформація з ЄДР
+}
+
+// PrimaryActivity
+// Main activity of the company, empty when not specified
+func (c *Fullcompany) PrimaryActivity() string {
+	for _, activity := range c.Activities {
+		if activity.IsPrimary {
+			return activity.Name
+		}
+	}
+
+	return ""
+}
+
+// GetFullCompany
+// Отримання повної інформації про компанію за кодом ЄДРПОУ
+// (англійські назви, керівники, засновники, філії, види діяльності,
+// стоп-фактори, ліцензії, банкрутство, рішення АМКУ)
+func (odb *OdbClient) GetFullCompany(
+	code string, // код ЄДРПОУ
+) (response *Fullcompany, err error) {
+	if code, err = normalizeEdrpou(code); err != nil {
+		return nil, err
+	}
+
+	if err = checkApiKey(odb); err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf(fullCompanyEndpoint, code)
+
+	err = odb.Do(endpoint, map[string]string{}, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+	//{
+	//  "full_name": "ПУБЛІЧНЕ АКЦІОНЕРНЕ ТОВАРИСТВО КОМЕРЦІЙНИЙ БАНК 'ПРИВАТБАНК'",
+	//  "short_name": "ПАТ КБ 'ПРИВАТБАНК'",
+	//  "full_name_en": "JOINT-STOCK COMPANY COMMERCIAL BANK 'PRIVATBANK'",
+	//  "short_name_en": "JSC CB 'PRIVATBANK'",
+	//  "code": "11111111",
+	//  "location": "01034, м.Київ, Шевченківський район, ВУЛИЦЯ ЯРОСЛАВІВ ВАЛ, будинок 55, корпус Б",
+	//  "ceo_name": "Петров Іван Володимирович",
+	//  "status": "зареєстровано",
+	//  "email": "mail@email.com",
+	//  "registration_date": "2017-01-01",
+	//  "capital": 206059743960,
+	//  "heads": [
+	//    {
+	//      "name": "Петров Іван Володимирович",
+	//      "role": "керівник",
+	//      "restriction": "Розпоряджається майном, що не перевищує 5% від суми статутного фонду"
+	//    }
+	//  ],
+	//  "activities": [
+	//    {
+	//      "name": "62.01 Комп'ютерне програмування",
+	//      "is_primary": true
+	//    }
+	//  ],
+	//  "last_time": "2018-01-01 19:04:32",
+	//  "phones": "+380111111111,+380222222222",
+	//  "beneficiaries": [
+	//    {
+	//      "name": "Петров Іван Володимирович",
+	//      "role": "засновник",
+	//      "amount": 3000,
+	//      "code": "12345678",
+	//      "location": "01034, м.Київ, Шевченківський район, ВУЛИЦЯ ЯРОСЛАВІВ ВАЛ, будинок 55, корпус Б"
+	//    }
+	//  ],
+	//  "history": [
+	//    {
+	//      "date": "2017-04-18",
+	//      "changes": [
+	//        {
+	//          "field": "керівник",
+	//          "old_value": "Петрова Галина Сергіївна",
+	//          "new_value": "Шевченко Галина Сергіївна"
+	//        }
+	//      ]
+	//    }
+	//  ],
+	//  "registrations": [
+	//    {
+	//      "end_date": "2017-04-18",
+	//      "code": "39484073",
+	//      "name": "КРОПИВНИЦЬКА ОБ'ЄДНАНА ДЕРЖАВНА ПОДАТКОВА IНСПЕКЦIЯ ГОЛОВНОГО УПРАВЛIННЯ ДФС У КIРОВОГРАДСЬКIЙ ОБЛАСТI",
+	//      "description": "дані про взяття на облік як платника податків",
+	//      "type": "taxoffice",
+	//      "start_date": "2017-04-18"
+	//    }
+	//  ],
+	//  "branches": [
+	//    {
+	//      "name": "НЕМИРІВСЬКА ФІЛІЯ ПРИВАТНОГО АКЦІОНЕРНОГО ТОВАРИСТВА 'ЗЕРНОПРОДУКТ МХП'",
+	//      "code": "33111519",
+	//      "address": {},
+	//      "id": 9316451,
+	//      "type": "122",
+	//      "type_text": "Філія (інший відокремлений підрозділ)"
+	//    }
+	//  ],
+	//  "is_modal_statute": false,
+	//  "statute": "01000000000000000000000999000000011111990000000000009901119999999990000",
+	//  "warnings": [
+	//    {
+	//      "tax_debts": {
+	//        "text": "Податковий борг на 01.02.2018 — 37 334 000 грн",
+	//        "icon": "⚠️",
+	//        "total": "37334",
+	//        "local": "3861.00",
+	//        "government": "33473.00",
+	//        "database_date": "01.02.2018",
+	//        "type": "available"
+	//      },
+	//      "bancruptcy": {
+	//        "text": "Процедури банкрутства — 1",
+	//        "icon": "⚠️",
+	//        "value": 1,
+	//        "list": [
+	//          {
+	//            "number": "53175",
+	//            "court": "922/1903/18",
+	//            "link": "http://vgsu.arbitr.gov.ua/pages/158/?d=53175&v=7697c35d05&t=3",
+	//            "date": "2018-07-30",
+	//            "type": "Оголошення про порушення справи про банкрутство"
+	//          }
+	//        ]
+	//      }
+	//    }
+	//  ],
+	//  "audits": [
+	//    {
+	//      "date": "2017-01-01",
+	//      "priority": "середній",
+	//      "agency": "Державна служба України з надзвичайних ситуацій"
+	//    }
+	//  ],
+	//  "licenses": {
+	//    "wholesale-alcohol": [
+	//      {
+	//        "department": "ДЕРЖАВНА ФІСКАЛЬНА СЛУЖБА УКРАЇНИ",
+	//        "number": "100198",
+	//        "start_date": "2017-01-01",
+	//        "end_date": "2017-01-01",
+	//        "active": 1
+	//      }
+	//    ]
+	//  },
+	//  "permits": [
+	//    {
+	//      "icon": "✅",
+	//      "text": "537 ліцензій на роздрібну торгівлю пальним",
+	//      "count": 537,
+	//      "type": "oil_license",
+	//      "subtype": "Роздрібна торгівля пальним",
+	//      "items": []
+	//    }
+	//  ],
+	//  "edr": {}
+	//}
+}
@@ -0,0 +1,126 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFullcompanyTypedModels(t *testing.T) {
+	var company Fullcompany
+
+	data := `{
+		"code": "14360570",
+		"branches": [
+			{"name": "ФІЛІЯ", "code": "33111519", "id": "9316451", "address": {
+				"zip": "22800", "country": "Україна", "address": "Вінницька обл., м. Немирів, вул. Шевченка, 1",
+				"parts": {"atu": "м. Немирів", "atu_code": "0522610100", "street": "вул. Шевченка", "house_type": "будинок", "house": "1"}}},
+			{"name": "ФІЛІЯ 2", "address": "м. Київ, вул. Хрещатик, 1"},
+			{"name": "ФІЛІЯ 3", "address": {}},
+			{"name": "ФІЛІЯ 4", "address": [1]}
+		],
+		"permits": [
+			{"type": "oil_license", "count": "2", "subtype": "Роздрібна торгівля пальним", "items": [
+				{"number": 100198, "type": "oil_license", "subtype": "Роздрібна торгівля пальним", "start_date": "2020-01-01", "active": "1"},
+				{"number": "100199", "end_date": "2021-01-01", "active": 0}
+			]},
+			{"type": "oil_excise", "count": 2, "items": [
+				{"number": "22000", "type": "oil_excise", "address": "м. Львів", "registration_date": "2019-05-06", "active": 1},
+				{"number": {}, "type": "oil_excise"},
+				{"type": "alcohol", "number": "1"}
+			]}
+		],
+		"edr": {
+			"registration": {"date": "1992-03-19", "record_number": "1 070 120 0000 005104"},
+			"address": {"address": "01001, м. Київ, вул. Грушевського, 1д"},
+			"extra": "kept in raw"
+		}
+	}`
+
+	if err := json.Unmarshal([]byte(data), &company); err != nil {
+		t.Fatal(err)
+	}
+
+	branches := company.Branches
+
+	if branches[0].Id != 9316451 || branches[0].Address.Zip != "22800" || branches[0].Address.Parts.AtuCode != "0522610100" || branches[0].Address.Raw != nil {
+		t.Errorf("branch address object: %+v", branches[0])
+	}
+
+	if branches[1].Address.String() != "м. Київ, вул. Хрещатик, 1" {
+		t.Errorf("branch address string: %+v", branches[1].Address)
+	}
+
+	if branches[2].Address.Address != "" || branches[2].Address.Raw != nil {
+		t.Errorf("branch empty address: %+v", branches[2].Address)
+	}
+
+	if string(branches[3].Address.Raw) != "[1]" {
+		t.Errorf("branch address fallback: %+v", branches[3].Address)
+	}
+
+	licenses := company.Permits[0]
+
+	if licenses.Count != 2 || licenses.Type != LicenseOilLicense || len(licenses.Items) != 2 {
+		t.Fatalf("license group: %+v", licenses)
+	}
+
+	for i, item := range licenses.Items {
+		if item.License == nil || item.Excise != nil || item.Raw != nil || item.License.Type != LicenseOilLicense {
+			t.Errorf("license item %d: %+v", i, item)
+		}
+	}
+
+	if licenses.Items[0].License.Number != "100198" || licenses.Items[0].License.Active != 1 || licenses.Items[1].License.EndDate.Year() != 2021 {
+		t.Errorf("license items: %+v, %+v", licenses.Items[0].License, licenses.Items[1].License)
+	}
+
+	excises := company.Permits[1]
+
+	if len(excises.Items) != 3 {
+		t.Fatalf("excise group: %+v", excises)
+	}
+
+	if excise := excises.Items[0].Excise; excise == nil || excise.Address != "м. Львів" || excise.RegistrationDate.Year() != 2019 {
+		t.Errorf("excise item: %+v", excises.Items[0])
+	}
+
+	for _, i := range []int{1, 2} {
+		if item := excises.Items[i]; item.License != nil || item.Excise != nil || item.Raw == nil {
+			t.Errorf("item %d kept raw: %+v", i, item)
+		}
+	}
+
+	encoded, err := json.Marshal(licenses.Items[0])
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var license OilLicenseItem
+
+	if err = json.Unmarshal(encoded, &license); err != nil || license.Number != "100198" {
+		t.Errorf("license item round trip: %s, %v", encoded, err)
+	}
+
+	edr := company.Edr
+
+	if edr == nil || edr.Registration == nil || edr.Registration.Date.Year() != 1992 || edr.Address.String() != "01001, м. Київ, вул. Грушевського, 1д" || len(edr.Raw) == 0 {
+		t.Errorf("edr: %+v", edr)
+	}
+}
+
+func TestFullcompanyEdrNull(t *testing.T) {
+	var company Fullcompany
+
+	if err := json.Unmarshal([]byte(`{"edr": null, "permits": null}`), &company); err != nil {
+		t.Fatal(err)
+	}
+
+	if company.Edr != nil || company.Permits != nil {
+		t.Errorf("company: %+v", company)
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LicenseType is a kind of fuel permit
+type LicenseType string
+
+const (
+	LicenseOilLicense LicenseType = "oil_license" // ліцензія на право роздрібної/оптової торгівлі, зберігання, виробництва пального
+	LicenseOilExcise  LicenseType = "oil_excise"  // реєстрація акцизного складу пального
+)
+
+type OilLicenseItem struct {
+	Number FlexString  `json:"number"` // Registration number
+	Type   LicenseType `json:"type"`
+	// Пальне
+	// Спирт
+	// Виробництво пального
+	// Зберігання пального
+	// Оптова торгівля пальним, за відсутності місць оптової торгівлі
+	// Оптова торгівля пальним, за наявності місць оптової торгівлі
+	// Роздрібна торгівля пальним
+	// Зберігання пального (виключно для потреб власного споживання чи промислової переробки)
+	Subtype         string  `json:"subtype"`
+	StartDate       Date    `json:"start_date"`
+	EndDate         Date    `json:"end_date"`
+	RenewalDate     Date    `json:"renewal_date"`
+	PauseDate       Date    `json:"pause_date"`
+	CancelationDate Date    `json:"cancelation_date"`
+	Active          FlexInt `json:"active"`
+}
+
+type OilExciseItem struct {
+	Number           FlexString  `json:"number"` // Registration number
+	Type             LicenseType `json:"type"`
+	Subtype          string      `json:"subtype"`
+	Address          string      `json:"address"`
+	RegistrationDate Date        `json:"registration_date"`
+	Active           FlexInt     `json:"active"`
+}
+
+// OilLicenses
+// Fuel licenses from the permits list
+func (l *LicensesData) OilLicenses() (items []OilLicenseItem) {
+	for _, permit := range l.Data.Items {
+		if permit.Type != LicenseOilLicense {
+			continue
+		}
+
+		items = append(items, OilLicenseItem{
+			Number:          permit.Number,
+			Type:            permit.Type,
+			Subtype:         permit.Subtype,
+			StartDate:       permit.StartDate,
+			EndDate:         permit.EndDate,
+			RenewalDate:     permit.RenewalDate,
+			PauseDate:       permit.PauseDate,
+			CancelationDate: permit.CancelationDate,
+			Active:          permit.Active,
+		})
+	}
+
+	return items
+}
+
+// OilExcises
+// Fuel excise warehouses from the permits list
+func (l *LicensesData) OilExcises() (items []OilExciseItem) {
+	for _, permit := range l.Data.Items {
+		if permit.Type != LicenseOilExcise {
+			continue
+		}
+
+		items = append(items, OilExciseItem{
+			Number:           permit.Number,
+			Type:             permit.Type,
+			Subtype:          permit.Subtype,
+			Address:          permit.Address,
+			RegistrationDate: permit.RegistrationDate,
+			Active:           permit.Active,
+		})
+	}
+
+	return items
+}
+
+type GasStationItem struct {
+	Code     FlexString  `json:"code"`      // Код ЄДРПОУ
+	FullName string      `json:"full_name"` // Повна назва компанії
+	Number   FlexString  `json:"number"`    // Registration number
+	Type     LicenseType `json:"type"`
+	// Пальне
+	// Спирт
+	// Виробництво пального
+	// Зберігання пального
+	// Оптова торгівля пальним, за відсутності місць оптової торгівлі
+	// Оптова торгівля пальним, за наявності місць оптової торгівлі
+	// Роздрібна торгівля пальним
+	// Зберігання пального (виключно для потреб власного споживання чи промислової переробки)
+	Subtype          string  `json:"subtype"`
+	Address          string  `json:"address"`
+	StartDate        Date    `json:"start_date"`
+	RegistrationDate Date    `json:"registration_date"`
+	EndDate          Date    `json:"end_date"`
+	RenewalDate      Date    `json:"renewal_date"`
+	PauseDate        Date    `json:"pause_date"`
+	CancelationDate  Date    `json:"cancelation_date"`
+	Active           FlexInt `json:"active"`   // status
+	Lat              float64 `json:"lat"`      // Координати широти точки
+	Lng              float64 `json:"lng"`      // Координати довготи точки
+	Distance         float64 `json:"distance"` // Відстань до точки пошуку в метрах
+}
+
+// CompanyCode
+// EDRPOU code of the station owner with leading zeros restored
+func (s *GasStationItem) CompanyCode() string {
+	code := s.Code.String()
+
+	if len(code) < 8 {
+		code = strings.Repeat("0", 8-len(code)) + code
+	}
+
+	return code
+}
+
+type GasStations struct {
+	Status string `json:"status"` // Статус запиту
+	Data   struct {
+		Count FlexInt          `json:"count"` // Кількість знайдених об'єктів
+		Items []GasStationItem `json:"items"`
+	} `json:"data"`
+}
+
+// GetGasStations
+// Пошук АЗС за координатами та радіусом або за регіоном
+func (odb *OdbClient) GetGasStations(
+	params map[string]string, // map[string]string{
+	//	"lat":			"Координати широти точки",
+	//	"lng":			"Координати довготи точки",
+	//	"radius":		"Радіус пошуку в метрах",
+	//	"region_id":	"Ідентифікатор регіону (1-27)",
+	//	"offset":		"Зміщення відносно початку результатів пошуку",
+	//	"limit":		"Кількість записів",
+	//}
+) (response *GasStations, err error) {
+	if err = checkApiKey(odb); err != nil {
+		return nil, err
+	}
+
+	err = odb.Do(gasStationsEndpoint, params, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+	//{
+	//  "status": "ok",
+	//  "data": {
+	//    "count": 1,
+	//    "items": [
+	//      {
+	//        "code": 11111111,
+	//        "full_name": "ПУБЛІЧНЕ АКЦІОНЕРНЕ ТОВАРИСТВО КОМЕРЦІЙНИЙ БАНК 'ПРИВАТБАНК'",
+	//        "number": 100198,
+	//        "type": "oil_excise",
+	//        "subtype": "Пальне",
+	//        "address": "string",
+	//        "start_date": "2017-01-01",
+	//        "registration_date": "2017-01-01",
+	//        "end_date": "2017-01-01",
+	//        "renewal_date": "2017-01-01",
+	//        "pause_date": "2017-01-01",
+	//        "cancelation_date": "2017-01-01",
+	//        "active": 1,
+	//        "lat": 50.0557,
+	//        "lng": 48.466206,
+	//        "distance": 10765
+	//      }
+	//    ]
+	//  }
+	//}
+}
+
+// GetGasStationsNear
+// Пошук АЗС в радіусі (в метрах) від точки
+func (odb *OdbClient) GetGasStationsNear(lat, lng, radius float64) (*GasStations, error) {
+	return odb.GetGasStations(map[string]string{
+		"lat":    strconv.FormatFloat(lat, 'f', -1, 64),
+		"lng":    strconv.FormatFloat(lng, 'f', -1, 64),
+		"radius": strconv.FormatFloat(radius, 'f', -1, 64),
+	})
+}
+
+// GetGasStationsByRegion
+// Пошук АЗС в регіоні
+func (odb *OdbClient) GetGasStationsByRegion(regionId int) (*GasStations, error) {
+	return odb.GetGasStations(map[string]string{
+		"region_id": strconv.Itoa(regionId),
+	})
+}
+
+// ExciseStatus is the result of checking a station against the excise warehouses of its owner
+type ExciseStatus string
+
+const (
+	ExciseActive    ExciseStatus = "active"    // знайдено діючий акцизний склад
+	ExciseLapsed    ExciseStatus = "lapsed"    // склади знайдено, але жоден не діє
+	ExciseNotFound  ExciseStatus = "not_found" // відповідного складу не знайдено, наприклад через написання адреси
+	ExciseUnchecked ExciseStatus = "unchecked" // дозволи власника не отримано, див. Err
+)
+
+type GasStationExcise struct {
+	Station GasStationItem  // АЗС
+	Excises []OilExciseItem // Акцизні склади компанії, що відповідають АЗС
+	Status  ExciseStatus    // Результат перевірки
+	Err     error           // Помилка отримання дозволів власника
+}
+
+// matchExcises
+// Excise warehouses matching the station by registration number or address
+func matchExcises(station GasStationItem, excises []OilExciseItem) (matched []OilExciseItem) {
+	address := strings.TrimSpace(station.Address)
+
+	for _, excise := range excises {
+		if (station.Number != "" && excise.Number == station.Number) ||
+			(address != "" && strings.EqualFold(strings.TrimSpace(excise.Address), address)) {
+			matched = append(matched, excise)
+		}
+	}
+
+	return matched
+}
+
+// CheckGasStationsExcise
+// Joins stations with the owner's permits (GetPermits is called once per company).
+// A station is lapsed only when matched warehouses exist and none of them is active;
+// a station without a matching warehouse is reported as not found.
+// A failed GetPermits request marks the stations of that company as unchecked.
+func (odb *OdbClient) CheckGasStationsExcise(stations []GasStationItem) (response []GasStationExcise) {
+	type permits struct {
+		excises []OilExciseItem
+		err     error
+	}
+
+	owners := map[string]permits{}
+
+	for _, station := range stations {
+		code := station.CompanyCode()
+		owner, ok := owners[code]
+
+		if !ok {
+			data, err := odb.GetPermits(map[string]string{"code": code})
+
+			if err == nil {
+				owner.excises = data.OilExcises()
+			} else {
+				owner.err = fmt.Errorf("%s: %w", code, err)
+			}
+
+			owners[code] = owner
+		}
+
+		result := GasStationExcise{Station: station, Err: owner.err}
+
+		if owner.err != nil {
+			result.Status = ExciseUnchecked
+		} else {
+			result.Excises = matchExcises(station, owner.excises)
+			result.Status = ExciseNotFound
+
+			for _, excise := range result.Excises {
+				result.Status = ExciseLapsed
+
+				if excise.Active == 1 {
+					result.Status = ExciseActive
+					break
+				}
+			}
+		}
+
+		response = append(response, result)
+	}
+
+	return response
+}
@@ -0,0 +1,73 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCheckGasStationsExcise(t *testing.T) {
+	var stations GasStations
+
+	data := `{"status":"ok","data":{"count":"4","items":[
+		{"code":"41711425","number":"100198","address":"м. Київ, вул. Хрещатик, 1","active":1},
+		{"code":32129,"number":200300,"address":"м. Львів","active":"1"},
+		{"code":"41711425","number":"100200","address":"м. Київ, вулиця Хрещатик, 3","active":1},
+		{"code":"32746583","number":"300400","address":"м. Одеса","active":1}]}}`
+
+	if err := json.Unmarshal([]byte(data), &stations); err != nil {
+		t.Fatal(err)
+	}
+
+	if stations.Data.Count != 4 || stations.Data.Items[1].CompanyCode() != "00032129" || stations.Data.Items[1].Number != "200300" {
+		t.Fatalf("stations %+v", stations.Data)
+	}
+
+	requests := map[string]int{}
+
+	client := newTestClient(t, func(req *http.Request) (int, interface{}) {
+		code := req.URL.Query().Get("code")
+		requests[code]++
+
+		switch code {
+		case "41711425":
+			return http.StatusOK, json.RawMessage(`{"status":"ok","data":{"count":1,"items":[
+				{"number":100198,"type":"oil_excise","active":1}]}}`)
+		case "32746583":
+			return http.StatusInternalServerError, json.RawMessage(`{"status":"error"}`)
+		}
+
+		return http.StatusOK, json.RawMessage(`{"status":"ok","data":{"count":1,"items":[
+			{"number":"999","type":"oil_excise","address":"м. Львів","active":0}]}}`)
+	})
+
+	results := client.CheckGasStationsExcise(stations.Data.Items)
+
+	if len(results) != 4 {
+		t.Fatalf("got %d results", len(results))
+	}
+
+	want := []ExciseStatus{ExciseActive, ExciseLapsed, ExciseNotFound, ExciseUnchecked}
+
+	for i, result := range results {
+		if result.Status != want[i] {
+			t.Errorf("station %d: status %s, want %s", i, result.Status, want[i])
+		}
+	}
+
+	if len(results[0].Excises) != 1 || len(results[1].Excises) != 1 || len(results[2].Excises) != 0 {
+		t.Errorf("matched excises: %+v", results)
+	}
+
+	if results[3].Err == nil || results[2].Err != nil {
+		t.Errorf("errors: %v, %v", results[2].Err, results[3].Err)
+	}
+
+	if requests["41711425"] != 1 {
+		t.Errorf("permits of one company requested %d times", requests["41711425"])
+	}
+}
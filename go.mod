@@ -1,3 +1,5 @@
 module odb
 
 go 1.17
+
+require gopkg.in/yaml.v3 v3.0.1
@@ -0,0 +1,280 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// HistoryType is a kind of monitoring notification
+type HistoryType string
+
+const (
+	HistoryNewPenaltyDocument HistoryType = "new_penalty_document" // новий документ по виконавчому провадженню
+	HistoryPenalty            HistoryType = "penalty"              // нове виконавче провадження в реєстрі боржників
+	HistoryRealty             HistoryType = "realty"               // зміна об'єктів нерухомості у реєстрі речових прав
+	HistoryWagedebt           HistoryType = "wagedebt"             // нова заборгованість по виплаті заробітної плати
+	HistoryInspections        HistoryType = "inspections"          // нова перевірка контролюючими органами
+	HistoryAudit              HistoryType = "audit"                // запланована перевірка контролюючими органами
+	HistoryDebt               HistoryType = "debt"                 // зміна статусу податкового боргу
+	HistoryBankruptcy         HistoryType = "bankruptcy"           // нове рішення по банкрутству
+	HistoryBeneficiary        HistoryType = "beneficiary"          // зміна данних по власникам
+	HistoryBeneficiariesUser  HistoryType = "beneficiaries_user"   // зміна данних по власникам компанії
+	HistoryEntrepreneur       HistoryType = "entrepreneur"         // зміна реєстраційних даних ФОПа
+	HistoryFopInn             HistoryType = "fopINN"               // зміна реєстраційних даних ФОПа по ІПН
+	HistoryWanted             HistoryType = "wanted"               // зміна стану розшукуваного
+	HistoryCorrupt            HistoryType = "corupt"               // нова інформація в реєстрі осіб, які вчинили корупційні правопорушення
+	HistoryLegal              HistoryType = "legal"                // зміна реєстраційних даних компанії
+	HistoryInvolved           HistoryType = "involved"             // нове судове засідання з входженням в тексті документа
+	HistorySession            HistoryType = "session"              // нове судове засідання за номером справи
+	HistoryCourt              HistoryType = "court"                // новий судовий документ
+	HistoryAliment            HistoryType = "aliment"              // зміна статусу аліментника
+	HistoryCancelDocument     HistoryType = "cancel_document"      // скасування документу виконавчого провадження
+)
+
+var historyTypeDescriptions = map[HistoryType]string{
+	HistoryNewPenaltyDocument: "новий документ по виконавчому провадженню",
+	HistoryPenalty:            "нове виконавче провадження в реєстрі боржників",
+	HistoryRealty:             "зміна об'єктів нерухомості у реєстрі речових прав",
+	HistoryWagedebt:           "нова заборгованість по виплаті заробітної плати",
+	HistoryInspections:        "нова перевірка контролюючими органами",
+	HistoryAudit:              "запланована перевірка контролюючими органами",
+	HistoryDebt:               "зміна статусу податкового боргу",
+	HistoryBankruptcy:         "нове рішення по банкрутству",
+	HistoryBeneficiary:        "зміна данних по власникам",
+	HistoryBeneficiariesUser:  "зміна данних по власникам компанії",
+	HistoryEntrepreneur:       "зміна реєстраційних даних ФОПа",
+	HistoryFopInn:             "зміна реєстраційних даних ФОПа по ІПН",
+	HistoryWanted:             "зміна стану розшукуваного",
+	HistoryCorrupt:            "нова інформація в реєстрі осіб, які вчинили корупційні правопорушення",
+	HistoryLegal:              "зміна реєстраційних даних компанії",
+	HistoryInvolved:           "нове судове засідання з входженням в тексті документа",
+	HistorySession:            "нове судове засідання за номером справи",
+	HistoryCourt:              "новий судовий документ",
+	HistoryAliment:            "зміна статусу аліментника",
+	HistoryCancelDocument:     "скасування документу виконавчого провадження",
+}
+
+// Description
+// Ukrainian description of the notification type
+func (t HistoryType) Description() string {
+	return historyTypeDescriptions[t]
+}
+
+// IsKnown
+// Reports whether the type is documented by the API
+func (t HistoryType) IsKnown() bool {
+	_, ok := historyTypeDescriptions[t]
+
+	return ok
+}
+
+type HistoryItem struct {
+	NotificationId FlexString  `json:"notification_id"` // Внутрішній ідентифікатор запису
+	Type           HistoryType `json:"type"`            // Вид підписки
+	Code           string      `json:"code"`            // Код ОКПО/хеш ФОПа
+	Date           Date        `json:"date"`            // Дата повідомлення
+	Text           string      `json:"text"`            // Текст повідомлення
+}
+
+// HistoryQuery is a typed set of GetHistory parameters
+type HistoryQuery struct {
+	Type   HistoryType // Вид повідомлення
+	Code   string      // Код ОКПО/хеш ФОПа
+	From   time.Time   // Фільтр за датою повідомлення з, день за київським часом
+	To     time.Time   // Фільтр за датою повідомлення по, день за київським часом
+	Offset int         // Зміщення відносно початку результатів пошуку
+	Limit  int         // Кількість записів
+}
+
+// Validate
+// Checks the type, the date range and paging
+func (q *HistoryQuery) Validate() error {
+	if q.Type != "" && !q.Type.IsKnown() {
+		return fmt.Errorf("Unknown history type %q", string(q.Type))
+	}
+
+	if !q.From.IsZero() && !q.To.IsZero() && q.To.Before(q.From) {
+		return errors.New("Date to is before date from")
+	}
+
+	if q.Offset < 0 || q.Limit < 0 {
+		return errors.New("Offset and limit must not be negative")
+	}
+
+	return nil
+}
+
+// Params
+// Query parameters for GetHistory
+func (q *HistoryQuery) Params() (params map[string]string, err error) {
+	if err = q.Validate(); err != nil {
+		return nil, err
+	}
+
+	params = map[string]string{}
+
+	setParam(params, "type", string(q.Type))
+	setParam(params, "code", q.Code)
+	setParam(params, "date_from", kyivDate(q.From))
+	setParam(params, "date_to", kyivDate(q.To))
+
+	if q.Offset != 0 {
+		params["offset"] = strconv.Itoa(q.Offset)
+	}
+
+	if q.Limit != 0 {
+		params["limit"] = strconv.Itoa(q.Limit)
+	}
+
+	return params, nil
+}
+
+// GetHistory
+// Отримання історії повідомлень за підписками, див. також SearchHistory
+func (odb *OdbClient) GetHistory(
+	params map[string]string, // map[string]string{
+	//	"type":			"Вид повідомлення (HistoryType), наприклад court",
+	//	"code":			"Код ОКПО/хеш ФОПа",
+	//	"date_from":	"Фільтр за датою повідомлення з (Y-m-d)",
+	//	"date_to":		"Фільтр за датою повідомлення по (Y-m-d)",
+	//	"offset":		"Зміщення відносно початку результатів пошуку",
+	//	"limit":		"Кількість записів",
+	//}
+) (response []HistoryItem, err error) {
+	if err = checkApiKey(odb); err != nil {
+		return nil, err
+	}
+
+	err = odb.Do(historyEndpoint, params, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+	//[
+	//  {
+	//    "notification_id": "1742908",
+	//    "type": "court",
+	//    "code": "23494714",
+	//    "date": "2018-07-01",
+	//    "text": "На 21.07.2018 додано 2 судових документа по компанії ПУБЛІЧНЕ АКЦІОНЕРНЕ ТОВАРИСТВО АЛЬФА-БАНК"
+	//  }
+	//]
+}
+
+// SearchHistory
+// Історія повідомлень за підписками з типізованим запитом
+func (odb *OdbClient) SearchHistory(query HistoryQuery) ([]HistoryItem, error) {
+	params, err := query.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return odb.GetHistory(params)
+}
+
+const (
+	historyPageLimit = 100  // Розмір сторінки GetAllHistory
+	historyMaxPages  = 1000 // Найбільша кількість сторінок GetAllHistory
+)
+
+// GetAllHistory
+// Walks through the pages of the history from query.Offset; query.Limit is ignored.
+// It stops with an error after historyMaxPages pages or when a page ends
+// with the same record as the previous one, i.e. the API ignores the offset.
+func (odb *OdbClient) GetAllHistory(query HistoryQuery) (response []HistoryItem, err error) {
+	query.Limit = historyPageLimit
+	lastId := FlexString("")
+
+	for page := 0; page < historyMaxPages; page++ {
+		items, err := odb.SearchHistory(query)
+
+		if err != nil {
+			return response, err
+		}
+
+		if len(items) > 0 && page > 0 && items[len(items)-1].NotificationId == lastId {
+			return response, fmt.Errorf("History page at offset %d repeats the previous page", query.Offset)
+		}
+
+		response = append(response, items...)
+
+		if len(items) < historyPageLimit {
+			return response, nil
+		}
+
+		lastId = items[len(items)-1].NotificationId
+		query.Offset += historyPageLimit
+	}
+
+	return response, fmt.Errorf("History has more than %d pages", historyMaxPages)
+}
+
+// WebhookNotification is the payload Opendatabot sends to the configured webhook
+type WebhookNotification struct {
+	Status string `json:"status"` // Статус операції
+	Data   struct {
+		NotificationId  FlexString  `json:"notification_id"`  // Внутрішній ідентифікатор запису
+		SubcustomerId   string      `json:"subcustomer_id"`   // Внутрішній ідентифікатор клієнта
+		Type            HistoryType `json:"type"`             // Вид підписки
+		TypeDescription string      `json:"type_description"` // Деталі підпискі
+		Code            string      `json:"code"`             // Код ОКПО/хеш ФОПа
+		Date            Date        `json:"date"`             // Дата повідомлення
+		Items           []struct {
+			Field     string   `json:"field"`
+			Record    string   `json:"record"`
+			Code      string   `json:"code"`
+			EventDate DateTime `json:"event_date"`
+			Text      string   `json:"text"`
+		} `json:"items"`
+	} `json:"data"`
+}
+
+// NotificationId
+// Notification id in the same form as HistoryItem.NotificationId
+func (w *WebhookNotification) NotificationId() string {
+	return w.Data.NotificationId.String()
+}
+
+// MissedNotifications
+// Returns history records whose notification id is not among received ids,
+// preserving the order of history
+func MissedNotifications(history []HistoryItem, receivedIds []string) (missed []HistoryItem) {
+	received := make(map[string]struct{}, len(receivedIds))
+
+	for _, id := range receivedIds {
+		received[id] = struct{}{}
+	}
+
+	for _, item := range history {
+		if _, ok := received[item.NotificationId.String()]; !ok {
+			missed = append(missed, item)
+		}
+	}
+
+	return missed
+}
+
+// ReconcileHistory
+// Loads the whole history matching the query and reports notifications
+// that were not received through the webhook
+func (odb *OdbClient) ReconcileHistory(
+	query HistoryQuery,
+	receivedIds []string, // notification_id, отримані через webhook
+) (missed []HistoryItem, err error) {
+	history, err := odb.GetAllHistory(query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return MissedNotifications(history, receivedIds), nil
+}
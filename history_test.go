@@ -0,0 +1,129 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestHistoryQueryParams(t *testing.T) {
+	query := HistoryQuery{
+		Type:   HistoryCourt,
+		Code:   "41711425",
+		From:   time.Date(2021, 12, 31, 23, 30, 0, 0, time.UTC),
+		To:     time.Date(2022, 1, 31, 0, 0, 0, 0, Kyiv),
+		Offset: 200,
+		Limit:  100,
+	}
+
+	params, err := query.Params()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"type":      "court",
+		"code":      "41711425",
+		"date_from": "2022-01-01",
+		"date_to":   "2022-01-31",
+		"offset":    "200",
+		"limit":     "100",
+	}
+
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("params %v, want %v", params, want)
+	}
+
+	if params, err = (&HistoryQuery{}).Params(); err != nil || len(params) != 0 {
+		t.Errorf("empty query params %v, %v", params, err)
+	}
+
+	invalid := []HistoryQuery{
+		{Type: "cuort"},
+		{From: time.Date(2022, 2, 1, 0, 0, 0, 0, Kyiv), To: time.Date(2022, 1, 1, 0, 0, 0, 0, Kyiv)},
+		{Offset: -1},
+	}
+
+	for _, query := range invalid {
+		if _, err := query.Params(); err == nil {
+			t.Errorf("query %+v accepted", query)
+		}
+	}
+}
+
+// historyPages answers history requests with total records paged by offset and limit
+func historyPages(total int, ignoreOffset bool) func(req *http.Request) (int, interface{}) {
+	return func(req *http.Request) (int, interface{}) {
+		offset, _ := strconv.Atoi(req.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+
+		if ignoreOffset {
+			offset = 0
+		}
+
+		items := []HistoryItem{}
+
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, HistoryItem{NotificationId: FlexString(strconv.Itoa(i + 1)), Type: HistoryCourt})
+		}
+
+		return http.StatusOK, items
+	}
+}
+
+func TestGetAllHistory(t *testing.T) {
+	client := newTestClient(t, historyPages(250, false))
+	items, err := client.GetAllHistory(HistoryQuery{Type: HistoryCourt})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 250 || items[249].NotificationId != "250" {
+		t.Fatalf("got %d items", len(items))
+	}
+
+	missed := MissedNotifications(items[:3], []string{"1", "3"})
+
+	if len(missed) != 1 || missed[0].NotificationId != "2" {
+		t.Errorf("missed %+v", missed)
+	}
+}
+
+func TestGetAllHistoryStopsWhenOffsetIsIgnored(t *testing.T) {
+	client := newTestClient(t, historyPages(1000, true))
+	items, err := client.GetAllHistory(HistoryQuery{})
+
+	if err == nil {
+		t.Fatal("paging that does not advance was not detected")
+	}
+
+	if len(items) != historyPageLimit {
+		t.Errorf("got %d items, want the first page", len(items))
+	}
+}
+
+func TestWebhookNotificationId(t *testing.T) {
+	for _, data := range []string{
+		`{"status":"ok","data":{"notification_id":1742908,"type":"court","code":"23494714"}}`,
+		`{"status":"ok","data":{"notification_id":"1742908","type":"court","code":"23494714"}}`,
+	} {
+		var notification WebhookNotification
+
+		if err := json.Unmarshal([]byte(data), &notification); err != nil {
+			t.Fatal(err)
+		}
+
+		if notification.NotificationId() != "1742908" {
+			t.Errorf("%s: id %q", data, notification.NotificationId())
+		}
+	}
+}
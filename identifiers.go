@@ -0,0 +1,304 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// EDRPOU is an 8-digit code of a legal entity in the Unified State Register
+type EDRPOU string
+
+// RNOKPP is a 10-digit taxpayer registration number of an individual (ІПН)
+type RNOKPP string
+
+// IdentifierKind is a kind of identifier recognized by DetectIdentifier
+type IdentifierKind int
+
+const (
+	IdentifierUnknown IdentifierKind = iota
+	IdentifierEDRPOU                 // Код ЄДРПОУ
+	IdentifierRNOKPP                 // РНОКПП (ІПН)
+)
+
+func (k IdentifierKind) String() string {
+	switch k {
+	case IdentifierEDRPOU:
+		return "EDRPOU"
+	case IdentifierRNOKPP:
+		return "RNOKPP"
+	}
+
+	return "unknown"
+}
+
+// onlyDigits
+// Digits of the value, false when it contains anything but digits,
+// spaces and dashes
+func onlyDigits(value string) (string, bool) {
+	var digits strings.Builder
+
+	for _, char := range value {
+		switch {
+		case char >= '0' && char <= '9':
+			digits.WriteRune(char)
+		case char == ' ' || char == '-' || char == '\t' || char == ' ':
+		default:
+			return "", false
+		}
+	}
+
+	return digits.String(), digits.Len() > 0
+}
+
+func digitAt(value string, i int) int {
+	return int(value[i] - '0')
+}
+
+func edrpouChecksum(code string) int {
+	weights := []int{1, 2, 3, 4, 5, 6, 7}
+
+	if code >= "30000000" && code <= "60000000" {
+		weights = []int{7, 1, 2, 3, 4, 5, 6}
+	}
+
+	sum := func(shift int) int {
+		total := 0
+
+		for i, weight := range weights {
+			total += digitAt(code, i) * (weight + shift)
+		}
+
+		return total % 11
+	}
+
+	check := sum(0)
+
+	if check == 10 {
+		check = sum(2) % 10
+	}
+
+	return check
+}
+
+// ParseEDRPOU
+// Normalizes the code (spaces are dropped, leading zeros restored)
+// and verifies its checksum
+func ParseEDRPOU(value string) (EDRPOU, error) {
+	digits, ok := onlyDigits(strings.TrimSpace(value))
+
+	if !ok || len(digits) > 8 {
+		return "", fmt.Errorf("Invalid EDRPOU code %q", value)
+	}
+
+	code := strings.Repeat("0", 8-len(digits)) + digits
+
+	if edrpouChecksum(code) != digitAt(code, 7) {
+		return "", fmt.Errorf("Invalid EDRPOU code %q: checksum mismatch", value)
+	}
+
+	return EDRPOU(code), nil
+}
+
+// IsValid
+// Reports whether the code is 8 digits with a correct checksum
+func (e EDRPOU) IsValid() bool {
+	parsed, err := ParseEDRPOU(string(e))
+
+	return err == nil && parsed == e
+}
+
+func (e EDRPOU) String() string {
+	return string(e)
+}
+
+func rnokppChecksum(code string) int {
+	weights := []int{-1, 5, 7, 9, 4, 6, 10, 5, 7}
+	total := 0
+
+	for i, weight := range weights {
+		total += digitAt(code, i) * weight
+	}
+
+	return ((total % 11) + 11) % 11 % 10
+}
+
+// ParseRNOKPP
+// Normalizes the number (spaces are dropped, a lost leading zero restored)
+// and verifies its checksum
+func ParseRNOKPP(value string) (RNOKPP, error) {
+	digits, ok := onlyDigits(strings.TrimSpace(value))
+
+	if !ok || len(digits) < 9 || len(digits) > 10 {
+		return "", fmt.Errorf("Invalid RNOKPP %q", value)
+	}
+
+	code := strings.Repeat("0", 10-len(digits)) + digits
+
+	if rnokppChecksum(code) != digitAt(code, 9) {
+		return "", fmt.Errorf("Invalid RNOKPP %q: checksum mismatch", value)
+	}
+
+	return RNOKPP(code), nil
+}
+
+// IsValid
+// Reports whether the number is 10 digits with a correct checksum
+func (r RNOKPP) IsValid() bool {
+	parsed, err := ParseRNOKPP(string(r))
+
+	return err == nil && parsed == r
+}
+
+func (r RNOKPP) String() string {
+	return string(r)
+}
+
+// BirthDate
+// Birth date encoded in the first five digits as days since 31.12.1899
+func (r RNOKPP) BirthDate() time.Time {
+	if len(r) != 10 {
+		return time.Time{}
+	}
+
+	days := 0
+
+	for i := 0; i < 5; i++ {
+		days = days*10 + digitAt(string(r), i)
+	}
+
+	return time.Date(1899, time.December, 31, 0, 0, 0, 0, Kyiv).AddDate(0, 0, days)
+}
+
+// Sex
+// "male" or "female" by parity of the ninth digit, as in the sex field of responses
+func (r RNOKPP) Sex() string {
+	if len(r) != 10 {
+		return ""
+	}
+
+	if digitAt(string(r), 8)%2 == 1 {
+		return "male"
+	}
+
+	return "female"
+}
+
+// identifierPattern matches a run of digits, possibly split by spaces or dashes
+var identifierPattern = regexp.MustCompile(`\d(?:[\d \-]*\d)?`)
+
+// DetectIdentifier
+// Recognizes an EDRPOU code or an RNOKPP in arbitrary input,
+// e.g. "ЄДРПОУ 1436 0570" or "ІПН: 1234567899", and returns it normalized
+func DetectIdentifier(value string) (IdentifierKind, string, error) {
+	matches := identifierPattern.FindAllString(value, -1)
+
+	if len(matches) != 1 {
+		return IdentifierUnknown, "", fmt.Errorf("Invalid identifier %q", value)
+	}
+
+	digits, _ := onlyDigits(matches[0])
+
+	if len(digits) <= 8 {
+		code, err := ParseEDRPOU(digits)
+
+		if err != nil {
+			return IdentifierUnknown, "", err
+		}
+
+		return IdentifierEDRPOU, string(code), nil
+	}
+
+	code, err := ParseRNOKPP(digits)
+
+	if err != nil {
+		return IdentifierUnknown, "", err
+	}
+
+	return IdentifierRNOKPP, string(code), nil
+}
+
+func normalizeEdrpou(code string) (string, error) {
+	if err := checkNotEmpty(code); err != nil {
+		return "", err
+	}
+
+	parsed, err := ParseEDRPOU(code)
+
+	return string(parsed), err
+}
+
+// normalizeEdrpouList
+// Normalizes comma separated EDRPOU codes
+func normalizeEdrpouList(codes string) (string, error) {
+	if err := checkNotEmpty(codes); err != nil {
+		return "", err
+	}
+
+	parts := strings.Split(codes, ",")
+
+	for i, part := range parts {
+		parsed, err := ParseEDRPOU(part)
+
+		if err != nil {
+			return "", err
+		}
+
+		parts[i] = string(parsed)
+	}
+
+	return strings.Join(parts, ","), nil
+}
+
+func normalizeRnokpp(code string) (string, error) {
+	if err := checkNotEmpty(code); err != nil {
+		return "", err
+	}
+
+	parsed, err := ParseRNOKPP(code)
+
+	return string(parsed), err
+}
+
+// normalizeTaxCode
+// Normalizes an EDRPOU code or an RNOKPP
+func normalizeTaxCode(code string) (string, error) {
+	if err := checkNotEmpty(code); err != nil {
+		return "", err
+	}
+
+	_, normalized, err := DetectIdentifier(code)
+
+	return normalized, err
+}
+
+// normalizeCodeParam
+// Normalizes params["code"] as an EDRPOU code or an RNOKPP when it is set
+func normalizeCodeParam(params map[string]string) (map[string]string, error) {
+	code, ok := params["code"]
+
+	if !ok {
+		return params, nil
+	}
+
+	normalized, err := normalizeTaxCode(code)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+
+	for key, value := range params {
+		result[key] = value
+	}
+
+	result["code"] = normalized
+
+	return result, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseEDRPOU(t *testing.T) {
+	tests := []struct {
+		value string
+		want  EDRPOU
+		fail  bool
+	}{
+		// below 30000000: weights 1..7
+		{value: "14360570", want: "14360570"},
+		{value: "10000001", want: "10000001"},
+		{value: "10000007", fail: true}, // checksum by the middle range weights
+		{value: "32129", want: "00032129"},
+		{value: "1436 0570", want: "14360570"},
+		// 30000000..60000000: weights 7, 1..6
+		{value: "32746583", want: "32746583"},
+		{value: "41711425", want: "41711425"},
+		{value: "35000004", want: "35000004"},
+		{value: "35000002", fail: true}, // checksum by the outer range weights
+		// above 60000000: weights 1..7
+		{value: "65000005", want: "65000005"},
+		// the sum gives 10, so the weights are rerun with +2
+		{value: "10000062", want: "10000062"},
+		{value: "35000016", want: "35000016"},
+		{value: "65000072", want: "65000072"},
+		{value: "30000005", want: "30000005"},
+		{value: "30000000", fail: true},
+		{value: "14360571", fail: true},
+		{value: "143605700", fail: true},
+		{value: "1436O570", fail: true},
+		{value: "", fail: true},
+	}
+
+	for _, test := range tests {
+		code, err := ParseEDRPOU(test.value)
+
+		if test.fail {
+			if err == nil {
+				t.Errorf("%q accepted as %s", test.value, code)
+			}
+
+			continue
+		}
+
+		if err != nil || code != test.want || !code.IsValid() {
+			t.Errorf("%q: got %q, %v, want %q", test.value, code, err, test.want)
+		}
+	}
+
+	if EDRPOU("32129").IsValid() {
+		t.Error("code without leading zeros is valid")
+	}
+}
+
+func TestParseRNOKPP(t *testing.T) {
+	tests := []struct {
+		value string
+		want  RNOKPP
+		birth time.Time
+		sex   string
+		fail  bool
+	}{
+		{value: "1234567899", want: "1234567899", birth: time.Date(1933, 10, 19, 0, 0, 0, 0, Kyiv), sex: "male"},
+		{value: "3456789020", want: "3456789020", birth: time.Date(1994, 8, 22, 0, 0, 0, 0, Kyiv), sex: "female"},
+		{value: " 3000 0000 08 ", want: "3000000008", birth: time.Date(1982, 2, 19, 0, 0, 0, 0, Kyiv), sex: "female"},
+		{value: "123456781", want: "0123456781", birth: time.Date(1903, 5, 19, 0, 0, 0, 0, Kyiv), sex: "female"},
+		{value: "1234567890", fail: true},
+		{value: "12345678", fail: true},
+		{value: "12345678990", fail: true},
+		{value: "12345x7899", fail: true},
+	}
+
+	for _, test := range tests {
+		code, err := ParseRNOKPP(test.value)
+
+		if test.fail {
+			if err == nil {
+				t.Errorf("%q accepted as %s", test.value, code)
+			}
+
+			continue
+		}
+
+		if err != nil || code != test.want || !code.IsValid() {
+			t.Errorf("%q: got %q, %v, want %q", test.value, code, err, test.want)
+			continue
+		}
+
+		if !code.BirthDate().Equal(test.birth) || code.Sex() != test.sex {
+			t.Errorf("%s: born %v, %s, want %v, %s", code, code.BirthDate(), code.Sex(), test.birth, test.sex)
+		}
+	}
+
+	if invalid := RNOKPP("123"); !invalid.BirthDate().IsZero() || invalid.Sex() != "" {
+		t.Errorf("short number: %v, %q", invalid.BirthDate(), invalid.Sex())
+	}
+}
+
+func TestDetectIdentifier(t *testing.T) {
+	tests := []struct {
+		value string
+		kind  IdentifierKind
+		code  string
+	}{
+		{"ЄДРПОУ 1436 0570", IdentifierEDRPOU, "14360570"},
+		{"код 32129", IdentifierEDRPOU, "00032129"},
+		{"ІПН: 1234567899", IdentifierRNOKPP, "1234567899"},
+		{"32746583 та 41711425", IdentifierUnknown, ""},
+		{"ІПН: 1234567890", IdentifierUnknown, ""},
+		{"без коду", IdentifierUnknown, ""},
+	}
+
+	for _, test := range tests {
+		kind, code, err := DetectIdentifier(test.value)
+
+		if kind != test.kind || code != test.code || (err == nil) != (test.kind != IdentifierUnknown) {
+			t.Errorf("%q: got %s %q, %v", test.value, kind, code, err)
+		}
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// katottgPattern matches a KATOTTG code: UA and 17 digits
+var katottgPattern = regexp.MustCompile(`^UA\d{17}$`)
+
+// KATOTTG is a code of the Codifier of administrative-territorial units
+// and territories of territorial communities, e.g. UA80000000000093317
+type KATOTTG string
+
+// ParseKATOTTG
+// Validates a KATOTTG code; the UA prefix may be omitted or in lower case
+func ParseKATOTTG(value string) (KATOTTG, error) {
+	code := strings.ToUpper(strings.Join(strings.Fields(value), ""))
+
+	if !strings.HasPrefix(code, "UA") {
+		code = "UA" + code
+	}
+
+	if !katottgPattern.MatchString(code) {
+		return "", fmt.Errorf("Invalid KATOTTG %q", value)
+	}
+
+	return KATOTTG(code), nil
+}
+
+// IsValid
+// Reports whether the code is UA and 17 digits
+func (k KATOTTG) IsValid() bool {
+	return katottgPattern.MatchString(string(k))
+}
+
+// Digits
+// The 17 digits without the UA prefix, as GetKoatuuRegionsByCode accepts them
+func (k KATOTTG) Digits() string {
+	return strings.TrimPrefix(string(k), "UA")
+}
+
+// Region
+// Region of the code, the first two digits are the same as in KOATUU
+func (k KATOTTG) Region() (Region, bool) {
+	if !k.IsValid() {
+		return 0, false
+	}
+
+	return RegionByKoatuu(k.Digits())
+}
+
+// KatottgMapping converts codes between KOATUU and KATOTTG down to settlements.
+// The package does not ship the correspondence table: it is published by the Ministry
+// for Communities and Territories Development and changes with the codifier, so the mapping
+// is read from the official table with ParseKatottgMapping or LoadKatottgMapping.
+// Region codes alone are available without it, see Region.KatottgCode.
+// A KOATUU code has at most one KATOTTG equivalent, while several
+// KOATUU codes may be merged into one KATOTTG unit, e.g. village councils of a hromada.
+type KatottgMapping struct {
+	katottg map[string]KATOTTG   // КОАТУУ -> КАТОТТГ
+	koatuu  map[KATOTTG][]string // КАТОТТГ -> всі коди КОАТУУ
+	names   map[KATOTTG]string   // Назви одиниць КАТОТТГ
+}
+
+// NewKatottgMapping
+// Empty mapping to fill with Add
+func NewKatottgMapping() *KatottgMapping {
+	return &KatottgMapping{
+		katottg: map[string]KATOTTG{},
+		koatuu:  map[KATOTTG][]string{},
+		names:   map[KATOTTG]string{},
+	}
+}
+
+// ParseKatottgMapping
+// Reads the correspondence table saved as CSV, separated by commas or semicolons.
+// Columns are found by their values, so both "koatuu,katottg,name" rows and the official
+// table with its own columns and headers can be read: a row gives a pair when it has
+// a 10-digit KOATUU code (9 digits when a spreadsheet dropped the leading zero)
+// and a KATOTTG code; the name is the first text after the KATOTTG code.
+// Rows without a pair, e.g. headers and new units without KOATUU, are skipped.
+// A KOATUU code mapped to different KATOTTG codes is an error.
+func ParseKatottgMapping(data []byte) (*KatottgMapping, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.LazyQuotes = true
+
+	header := data
+
+	if end := bytes.IndexByte(data, '\n'); end >= 0 {
+		header = data[:end]
+	}
+
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	records, err := reader.ReadAll()
+
+	if err != nil {
+		return nil, err
+	}
+
+	mapping := NewKatottgMapping()
+
+	for i, record := range records {
+		koatuu, katottg, name := katottgRow(record)
+
+		if koatuu == "" || katottg == "" {
+			continue
+		}
+
+		if err = mapping.Add(koatuu, katottg, name); err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+	}
+
+	return mapping, nil
+}
+
+// katottgRow
+// KOATUU code, KATOTTG code and name of a table row, empty when not found.
+// The name is the first text after the KATOTTG code, or before it when there is none.
+func katottgRow(record []string) (koatuu, katottg, name string) {
+	before := ""
+
+	for _, value := range record {
+		value = strings.TrimSpace(value)
+
+		switch {
+		case katottg == "" && katottgPattern.MatchString(strings.ToUpper(value)):
+			katottg = value
+		case koatuu == "" && len(value) == 10 && isDigits(value):
+			koatuu = value
+		case koatuu == "" && len(value) == 9 && isDigits(value):
+			koatuu = "0" + value
+		case !isKatottgName(value):
+		case katottg == "" && before == "":
+			before = value
+		case katottg != "" && name == "":
+			name = value
+		}
+	}
+
+	if name == "" {
+		name = before
+	}
+
+	return koatuu, katottg, name
+}
+
+// isKatottgName
+// Reports whether a cell is a name rather than a code or an object category letter
+func isKatottgName(value string) bool {
+	return len([]rune(value)) > 1 && !isDigits(value)
+}
+
+// LoadKatottgMapping
+// Reads a mapping table from a .csv file
+func LoadKatottgMapping(path string) (*KatottgMapping, error) {
+	data, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseKatottgMapping(data)
+}
+
+// Add
+// Adds a pair of codes; adding a pair again is not an error.
+// The KOATUU code must have 10 digits.
+func (m *KatottgMapping) Add(koatuu, katottg, name string) error {
+	koatuuCode, err := ParseKoatuuCode(koatuu)
+
+	if err != nil {
+		return err
+	}
+
+	if len(koatuuCode) != 10 {
+		return fmt.Errorf("KOATUU code %q must have 10 digits", koatuu)
+	}
+
+	katottgCode, err := ParseKATOTTG(katottg)
+
+	if err != nil {
+		return err
+	}
+
+	if existing, ok := m.katottg[koatuuCode]; ok {
+		if existing != katottgCode {
+			return fmt.Errorf("KOATUU %s is mapped to both %s and %s", koatuuCode, existing, katottgCode)
+		}
+
+		return nil
+	}
+
+	m.katottg[koatuuCode] = katottgCode
+	m.koatuu[katottgCode] = append(m.koatuu[katottgCode], koatuuCode)
+	sort.Strings(m.koatuu[katottgCode])
+
+	if name != "" {
+		m.names[katottgCode] = strings.TrimSpace(name)
+	}
+
+	return nil
+}
+
+// Merge
+// Adds all pairs of the other mapping, e.g. a table of changes over the full one
+func (m *KatottgMapping) Merge(other *KatottgMapping) error {
+	for koatuu, katottg := range other.katottg {
+		if err := m.Add(koatuu, string(katottg), other.names[katottg]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Len
+// Number of mapped KOATUU codes
+func (m *KatottgMapping) Len() int {
+	return len(m.katottg)
+}
+
+// Katottg
+// KATOTTG equivalent of a KOATUU code
+func (m *KatottgMapping) Katottg(koatuu string) (KATOTTG, bool) {
+	code, err := ParseKoatuuCode(koatuu)
+
+	if err != nil {
+		return "", false
+	}
+
+	katottg, ok := m.katottg[code]
+
+	return katottg, ok
+}
+
+// Koatuu
+// All KOATUU codes merged into the KATOTTG unit, sorted;
+// more than one code is a many-to-one case
+func (m *KatottgMapping) Koatuu(katottg KATOTTG) []string {
+	return m.koatuu[katottg]
+}
+
+// SingleKoatuu
+// KOATUU equivalent of a KATOTTG code, false when there is none
+// or the unit was merged from several KOATUU objects
+func (m *KatottgMapping) SingleKoatuu(katottg KATOTTG) (string, bool) {
+	if codes := m.koatuu[katottg]; len(codes) == 1 {
+		return codes[0], true
+	}
+
+	return "", false
+}
+
+// IsMerged
+// Reports whether several KOATUU codes map to the KATOTTG unit
+func (m *KatottgMapping) IsMerged(katottg KATOTTG) bool {
+	return len(m.koatuu[katottg]) > 1
+}
+
+// Name
+// Name of the KATOTTG unit from the table
+func (m *KatottgMapping) Name(katottg KATOTTG) string {
+	return m.names[katottg]
+}
+
+// SetKatottg
+// Fills Katottg of every node known to the mapping and returns their number
+func (t *KoatuuTree) SetKatottg(mapping *KatottgMapping) (mapped int) {
+	t.index()
+
+	for code, node := range t.nodes {
+		if katottg, ok := mapping.Katottg(code); ok {
+			node.Katottg = katottg
+			mapped++
+		}
+	}
+
+	return mapped
+}
+
+// NodesByKatottg
+// Nodes with the KATOTTG code set by SetKatottg, several for merged units
+func (t *KoatuuTree) NodesByKatottg(katottg KATOTTG) (nodes []*KoatuuNode) {
+	t.index()
+
+	for _, node := range t.nodes {
+		if node.Katottg == katottg {
+			nodes = append(nodes, node)
+		}
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Code < nodes[j].Code
+	})
+
+	return nodes
+}
@@ -0,0 +1,157 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"reflect"
+	"testing"
+)
+
+// mergedHromada is a fixture in the format of the correspondence table:
+// three village councils of KOATUU merged into one hromada of KATOTTG,
+// and a town that kept its own unit. The codes are not from the codifier.
+const mergedHromada = `koatuu,katottg,name
+3222410100,UA32100000010012345,Тестова міська громада
+3222480400,UA32100000010012345,Тестова міська громада
+3222482000,UA32100000010012345,Тестова міська громада
+3222455100,UA32100000020054321,Інша селищна громада
+`
+
+func TestRegionKatottgCode(t *testing.T) {
+	for _, region := range Regions {
+		code := region.KatottgCode()
+
+		if got, ok := code.Region(); !code.IsValid() || !ok || got != region {
+			t.Errorf("%s: KATOTTG %s gives region %v %v", region, code, got, ok)
+		}
+	}
+
+	if RegionKyiv.KatottgCode() != "UA80000000000093317" {
+		t.Errorf("Kyiv KATOTTG %s", RegionKyiv.KatottgCode())
+	}
+}
+
+func TestParseKatottgMappingOfficialLayout(t *testing.T) {
+	// Semicolons, title and header rows, the KOATUU of Crimea without its leading zero,
+	// the name before the KATOTTG code and a new unit without KOATUU
+	data := "\xef\xbb\xbfТаблиця відповідності;;;\n" +
+		"Код КОАТУУ;Назва;Код КАТОТТГ;Категорія\n" +
+		"100000000;Автономна Республіка Крим;UA01000000000013043;O\n" +
+		"3222410100;Тестове;UA32100000010012345;C\n" +
+		";Нова громада;UA32100000030011111;H\n"
+
+	mapping, err := ParseKatottgMapping([]byte(data))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mapping.Len() != 2 {
+		t.Fatalf("mapping has %d rows, want 2", mapping.Len())
+	}
+
+	if katottg, ok := mapping.Katottg("0100000000"); !ok || katottg != RegionCrimea.KatottgCode() || mapping.Name(katottg) != "Автономна Республіка Крим" {
+		t.Errorf("Katottg(0100000000) = %s %v %q", katottg, ok, mapping.Name(katottg))
+	}
+
+	if mapping.Name("UA32100000010012345") != "Тестове" {
+		t.Errorf("name %q", mapping.Name("UA32100000010012345"))
+	}
+}
+
+func TestKatottgMappingMergedUnit(t *testing.T) {
+	mapping, err := ParseKatottgMapping([]byte("koatuu,katottg,name\n3200000000,UA32000000000030281,Київська область\n"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := ParseKatottgMapping([]byte(mergedHromada))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = mapping.Merge(loaded); err != nil {
+		t.Fatal(err)
+	}
+
+	if mapping.Name("UA32100000010012345") != "Тестова міська громада" {
+		t.Errorf("name %q", mapping.Name("UA32100000010012345"))
+	}
+
+	merged := KATOTTG("UA32100000010012345")
+
+	if !mapping.IsMerged(merged) {
+		t.Error("hromada is not merged")
+	}
+
+	if codes := mapping.Koatuu(merged); !reflect.DeepEqual(codes, []string{"3222410100", "3222480400", "3222482000"}) {
+		t.Errorf("Koatuu(merged) = %v", codes)
+	}
+
+	if _, ok := mapping.SingleKoatuu(merged); ok {
+		t.Error("merged unit has a single KOATUU code")
+	}
+
+	if koatuu, ok := mapping.SingleKoatuu("UA32100000020054321"); !ok || koatuu != "3222455100" {
+		t.Errorf("SingleKoatuu = %s %v", koatuu, ok)
+	}
+
+	for _, koatuu := range []string{"3222410100", "3222480400", "3222482000"} {
+		if katottg, _ := mapping.Katottg(koatuu); katottg != merged {
+			t.Errorf("Katottg(%s) = %s", koatuu, katottg)
+		}
+	}
+
+	tree := &KoatuuTree{Regions: []*KoatuuNode{{
+		Code: "3200000000",
+		Name: "Київська",
+		Items: []*KoatuuNode{
+			{Code: "3222410100", Name: "Перше"},
+			{Code: "3222480400", Name: "Друге"},
+			{Code: "3222482000", Name: "Третє"},
+			{Code: "3222455100", Name: "Селище"},
+		},
+	}}}
+
+	if mapped := tree.SetKatottg(mapping); mapped != 5 {
+		t.Errorf("SetKatottg mapped %d nodes, want 5", mapped)
+	}
+
+	if nodes := tree.NodesByKatottg(merged); len(nodes) != 3 || nodes[0].Code != "3222410100" {
+		t.Errorf("NodesByKatottg(merged) = %v", nodes)
+	}
+}
+
+func TestKatottgMappingAddRejectsInvalidCodes(t *testing.T) {
+	mapping := NewKatottgMapping()
+
+	tests := []struct {
+		koatuu, katottg string
+	}{
+		{"32100000010012345", "UA32100000010012345"},
+		{"32224101", "UA32100000010012345"},
+		{"3222410100", "UA321"},
+	}
+
+	for _, test := range tests {
+		if err := mapping.Add(test.koatuu, test.katottg, ""); err == nil {
+			t.Errorf("Add(%s, %s) accepted", test.koatuu, test.katottg)
+		}
+	}
+
+	if err := mapping.Add("3222410100", "32100000010012345", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mapping.Add("3222410100", "UA32100000010012345", ""); err != nil {
+		t.Errorf("adding the same pair again: %v", err)
+	}
+
+	if err := mapping.Add("3222410100", "UA32100000020054321", ""); err == nil {
+		t.Error("KOATUU mapped to two KATOTTG codes")
+	}
+}
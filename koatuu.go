@@ -0,0 +1,380 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// koatuuCodePattern matches 10-digit KOATUU codes and the 17 digits of KATOTTG codes
+var koatuuCodePattern = regexp.MustCompile(`^(\d{10}|\d{17})$`)
+
+// ParseKoatuuCode
+// Validates a code accepted by GetKoatuuRegionsByCode: 10 digits of KOATUU
+// or 17 digits of KATOTTG without the UA prefix; spaces are removed.
+// A 10-digit code must start with the code of a known region.
+func ParseKoatuuCode(code string) (string, error) {
+	normalized := strings.Join(strings.Fields(code), "")
+
+	if !koatuuCodePattern.MatchString(normalized) {
+		return "", fmt.Errorf("KOATUU code %q must have 10 or 17 digits", code)
+	}
+
+	if _, ok := RegionByKoatuu(normalized); len(normalized) == 10 && !ok {
+		return "", fmt.Errorf("Unknown region of KOATUU code %q", code)
+	}
+
+	return normalized, nil
+}
+
+// KoatuuNode is an object of the KOATUU hierarchy
+type KoatuuNode struct {
+	Code    string        `json:"code"`              // Код КОАТУУ
+	Name    string        `json:"name"`              // Назва
+	Type    string        `json:"type"`              // region, region-district, city-and-district, city, city-district
+	Katottg KATOTTG       `json:"katottg,omitempty"` // Код КАТОТТГ, див. SetKatottg
+	Items   []*KoatuuNode `json:"items,omitempty"`   // Підпорядковані об'єкти
+}
+
+// KoatuuTree is the KOATUU hierarchy kept in memory.
+// It is crawled once with CrawlKoatuuTree, saved with Snapshot
+// and answers queries offline after ParseKoatuuTree.
+type KoatuuTree struct {
+	Date    time.Time     `json:"date"`    // Дата обходу
+	Regions []*KoatuuNode `json:"regions"` // Області, АР Крим, Київ і Севастополь
+
+	once    sync.Once
+	nodes   map[string]*KoatuuNode
+	parents map[string]*KoatuuNode
+}
+
+// index
+// Builds the lookup maps on first use
+func (t *KoatuuTree) index() {
+	t.once.Do(func() {
+		t.nodes = map[string]*KoatuuNode{}
+		t.parents = map[string]*KoatuuNode{}
+
+		var walk func(parent *KoatuuNode, items []*KoatuuNode)
+
+		walk = func(parent *KoatuuNode, items []*KoatuuNode) {
+			for _, node := range items {
+				t.nodes[node.Code] = node
+
+				if parent != nil {
+					t.parents[node.Code] = parent
+				}
+
+				walk(node, node.Items)
+			}
+		}
+
+		walk(nil, t.Regions)
+	})
+}
+
+// ParseKoatuuTree
+// Reads a snapshot made by Snapshot, e.g. embedded into the application
+func ParseKoatuuTree(data []byte) (*KoatuuTree, error) {
+	tree := &KoatuuTree{}
+
+	if err := json.Unmarshal(data, tree); err != nil {
+		return nil, err
+	}
+
+	tree.index()
+
+	return tree, nil
+}
+
+// LoadKoatuuTree
+// Reads a snapshot from a .json file
+func LoadKoatuuTree(path string) (*KoatuuTree, error) {
+	data, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseKoatuuTree(data)
+}
+
+// Snapshot
+// JSON form of the tree to store or embed
+func (t *KoatuuTree) Snapshot() ([]byte, error) {
+	return json.Marshal(t)
+}
+
+// Len
+// Number of objects in the tree
+func (t *KoatuuTree) Len() int {
+	t.index()
+
+	return len(t.nodes)
+}
+
+// Node
+// Object by KOATUU code
+func (t *KoatuuTree) Node(code string) (*KoatuuNode, bool) {
+	t.index()
+
+	node, ok := t.nodes[strings.TrimSpace(code)]
+
+	return node, ok
+}
+
+// Parent
+// Object the code belongs to, false for regions and unknown codes
+func (t *KoatuuTree) Parent(code string) (*KoatuuNode, bool) {
+	t.index()
+
+	parent, ok := t.parents[strings.TrimSpace(code)]
+
+	return parent, ok
+}
+
+// Children
+// Objects directly below the code
+func (t *KoatuuTree) Children(code string) []*KoatuuNode {
+	if node, ok := t.Node(code); ok {
+		return node.Items
+	}
+
+	return nil
+}
+
+// Path
+// Objects from the region down to the code itself, nil for an unknown code
+func (t *KoatuuTree) Path(code string) (path []*KoatuuNode) {
+	node, ok := t.Node(code)
+
+	for ok {
+		path = append([]*KoatuuNode{node}, path...)
+		node, ok = t.parents[node.Code]
+	}
+
+	return path
+}
+
+// PathNames
+// Names along the path joined with ", ", e.g. "Одеська, Одеса, Київський"
+func (t *KoatuuTree) PathNames(code string) string {
+	var names []string
+
+	for _, node := range t.Path(code) {
+		names = append(names, node.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// Search
+// Objects whose name contains the query ignoring case and apostrophes,
+// exact matches first, then by code
+func (t *KoatuuTree) Search(query string) (found []*KoatuuNode) {
+	t.index()
+
+	normalize := func(value string) string {
+		return strings.ToLower(apostrophes.Replace(strings.TrimSpace(value)))
+	}
+
+	query = normalize(query)
+
+	if query == "" {
+		return nil
+	}
+
+	for _, node := range t.nodes {
+		if strings.Contains(normalize(node.Name), query) {
+			found = append(found, node)
+		}
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		exactI, exactJ := normalize(found[i].Name) == query, normalize(found[j].Name) == query
+
+		if exactI != exactJ {
+			return exactI
+		}
+
+		return found[i].Code < found[j].Code
+	})
+
+	return found
+}
+
+// koatuuRetries is how many times a rate limited KOATUU request is retried
+const koatuuRetries = 5
+
+// koatuuMinBackoff is the shortest pause before retrying a rate limited request
+const koatuuMinBackoff = time.Second
+
+// CrawlKoatuuTree
+// Requests the whole hierarchy one level at a time, pausing interval between requests.
+// depth limits the levels below the regions, 0 means no limit: objects
+// without items are requested until the API has nothing below them.
+// Rate limited requests are retried with exponential backoff.
+func (odb *OdbClient) CrawlKoatuuTree(
+	ctx context.Context,
+	interval time.Duration, // пауза між запитами
+	depth int, // кількість рівнів під регіонами, 0 без обмеження
+) (*KoatuuTree, error) {
+	crawler := &koatuuCrawler{
+		ctx:        ctx,
+		client:     odb.WithContext(ctx),
+		interval:   interval,
+		minBackoff: koatuuMinBackoff,
+		requested:  map[string]bool{},
+	}
+
+	return crawler.crawl(depth)
+}
+
+type koatuuCrawler struct {
+	ctx        context.Context
+	client     *OdbClient
+	interval   time.Duration
+	minBackoff time.Duration   // Найкоротша пауза перед повтором запиту
+	requests   int             // Кількість виконаних запитів
+	requested  map[string]bool // Коди, які вже запитано
+}
+
+// crawl
+// Requests the regions and expands them depth levels down
+func (c *koatuuCrawler) crawl(depth int) (*KoatuuTree, error) {
+	var regions *KoatuuRegions
+
+	err := c.request(func() (err error) {
+		regions, err = c.client.GetKoatuuRegions()
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	tree := &KoatuuTree{Date: time.Now()}
+
+	for _, item := range regions.Data {
+		tree.Regions = append(tree.Regions, &KoatuuNode{Code: item.Code, Name: item.Name, Type: item.Type})
+	}
+
+	if err = c.expand(tree.Regions, 1, depth); err != nil {
+		return nil, err
+	}
+
+	tree.index()
+
+	return tree, nil
+}
+
+// request
+// Calls fn after the interval since the previous request, retrying rate limited responses
+func (c *koatuuCrawler) request(fn func() error) error {
+	if c.requests > 0 {
+		if err := sleepContext(c.ctx, c.interval); err != nil {
+			return err
+		}
+	}
+
+	c.requests++
+
+	backoff := c.interval
+
+	if backoff < c.minBackoff {
+		backoff = c.minBackoff
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := fn()
+
+		var apiErr *ApiError
+
+		if err == nil || !errors.As(err, &apiErr) || !apiErr.IsRateLimited() || attempt == koatuuRetries {
+			return err
+		}
+
+		if err = sleepContext(c.ctx, backoff); err != nil {
+			return err
+		}
+
+		backoff *= 2
+	}
+}
+
+// expand
+// Requests items of the nodes that have none yet, then goes one level down
+func (c *koatuuCrawler) expand(nodes []*KoatuuNode, level, depth int) error {
+	if depth > 0 && level > depth {
+		return nil
+	}
+
+	for _, node := range nodes {
+		if len(node.Items) == 0 && node.Type != "city-district" && !c.requested[node.Code] {
+			var koatuu *Koatuu
+
+			c.requested[node.Code] = true
+
+			err := c.request(func() (err error) {
+				koatuu, err = c.client.GetKoatuuRegionsByCode(node.Code)
+
+				return err
+			})
+
+			var apiErr *ApiError
+
+			switch {
+			case errors.As(err, &apiErr) && apiErr.IsNotFound():
+				continue
+			case err != nil:
+				return fmt.Errorf("KOATUU %s: %w", node.Code, err)
+			}
+
+			node.Items = koatuu.nodes()
+		}
+
+		if err := c.expand(node.Items, level+1, depth); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nodes
+// Items of the response as tree nodes, cities with their districts
+func (k *Koatuu) nodes() (nodes []*KoatuuNode) {
+	items := k.Data.Items
+
+	for _, item := range items.RegionDistrict {
+		nodes = append(nodes, &KoatuuNode{Code: item.Code, Name: item.Name, Type: item.Type})
+	}
+
+	for _, item := range items.CityAndDistrict {
+		nodes = append(nodes, &KoatuuNode{Code: item.Code, Name: item.Name, Type: item.Type})
+	}
+
+	for _, city := range items.City {
+		node := &KoatuuNode{Code: city.Code, Name: city.Name, Type: city.Type}
+
+		for _, district := range city.Districts {
+			node.Items = append(node.Items, &KoatuuNode{Code: district.Code, Name: district.Name, Type: district.Type})
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
@@ -0,0 +1,263 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testKoatuuTree
+//
+//	Одеська
+//	├── Ананьївський район
+//	│   └── Ананьїв
+//	└── Одеса
+//	    └── Київський
+//	Київ
+//	└── Голосіївський район
+func testKoatuuTree() *KoatuuTree {
+	return &KoatuuTree{Regions: []*KoatuuNode{
+		{Code: "5100000000", Name: "Одеська", Type: "region", Items: []*KoatuuNode{
+			{Code: "5121000000", Name: "Ананьївський район", Type: "region-district", Items: []*KoatuuNode{
+				{Code: "5121010100", Name: "Ананьїв", Type: "city"},
+			}},
+			{Code: "5110100000", Name: "Одеса", Type: "city", Items: []*KoatuuNode{
+				{Code: "5110136900", Name: "Київський", Type: "city-district"},
+			}},
+		}},
+		{Code: "8000000000", Name: "Київ", Type: "region", Items: []*KoatuuNode{
+			{Code: "8036100000", Name: "Голосіївський район", Type: "city-and-district"},
+		}},
+	}}
+}
+
+func koatuuCodes(nodes []*KoatuuNode) (codes []string) {
+	for _, node := range nodes {
+		codes = append(codes, node.Code)
+	}
+
+	return codes
+}
+
+func TestKoatuuTree(t *testing.T) {
+	tree := testKoatuuTree()
+
+	if tree.Len() != 7 {
+		t.Errorf("len %d", tree.Len())
+	}
+
+	if path := koatuuCodes(tree.Path("5110136900")); !reflect.DeepEqual(path, []string{"5100000000", "5110100000", "5110136900"}) {
+		t.Errorf("path %v", path)
+	}
+
+	if names := tree.PathNames(" 5110136900 "); names != "Одеська, Одеса, Київський" {
+		t.Errorf("path names %q", names)
+	}
+
+	if tree.Path("5199999999") != nil || tree.PathNames("5199999999") != "" {
+		t.Error("unknown code has a path")
+	}
+
+	if parent, ok := tree.Parent("5121010100"); !ok || parent.Code != "5121000000" {
+		t.Errorf("parent %+v", parent)
+	}
+
+	if _, ok := tree.Parent("5100000000"); ok {
+		t.Error("region has a parent")
+	}
+
+	if children := koatuuCodes(tree.Children("5100000000")); !reflect.DeepEqual(children, []string{"5121000000", "5110100000"}) {
+		t.Errorf("children %v", children)
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"київ", []string{"8000000000", "5110136900"}},
+		{"АНАНЬЇВ", []string{"5121010100", "5121000000"}},
+		{"район", []string{"5121000000", "8036100000"}},
+		{" ", nil},
+		{"Львів", nil},
+	}
+
+	for _, test := range tests {
+		if found := koatuuCodes(tree.Search(test.query)); !reflect.DeepEqual(found, test.want) {
+			t.Errorf("search %q: %v, want %v", test.query, found, test.want)
+		}
+	}
+}
+
+func TestKoatuuTreeSnapshot(t *testing.T) {
+	tree := testKoatuuTree()
+	tree.Date = time.Date(2022, 6, 1, 12, 0, 0, 0, Kyiv)
+
+	data, err := tree.Snapshot()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "koatuu.json")
+
+	if err = ioutil.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadKoatuuTree(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !loaded.Date.Equal(tree.Date) || loaded.Len() != tree.Len() || loaded.PathNames("5121010100") != tree.PathNames("5121010100") {
+		t.Errorf("loaded tree %s", data)
+	}
+
+	again, err := loaded.Snapshot()
+
+	if err != nil || string(again) != string(data) {
+		t.Errorf("snapshot changed:\n%s\n%s", data, again)
+	}
+
+	if _, err = ParseKoatuuTree([]byte(`{"regions": {}}`)); err == nil {
+		t.Error("invalid snapshot accepted")
+	}
+}
+
+// koatuuServer serves the hierarchy of testKoatuuTree level by level,
+// answering the first requests of the codes in limited with 429
+type koatuuServer struct {
+	limited  map[string]int
+	requests []string
+}
+
+func (s *koatuuServer) handle(req *http.Request) (int, interface{}) {
+	code := strings.TrimPrefix(strings.TrimPrefix(req.URL.Path, "/api/v2/koatuu/regions"), "/")
+	s.requests = append(s.requests, code)
+
+	if s.limited[code] > 0 {
+		s.limited[code]--
+
+		return http.StatusTooManyRequests, nil
+	}
+
+	switch code {
+	case "":
+		return http.StatusOK, json.RawMessage(`{"status":"ok","data":[
+			{"code":"5100000000","name":"Одеська","type":"region"},
+			{"code":"8000000000","name":"Київ","type":"region"}]}`)
+	case "5100000000":
+		return http.StatusOK, json.RawMessage(`{"status":"ok","data":{"items":{
+			"region-district":[{"code":"5121000000","name":"Ананьївський район","type":"region-district"}],
+			"city":[{"code":"5110100000","name":"Одеса","type":"city","districts":[
+				{"code":"5110136900","name":"Київський","type":"city-district"}]}]}}}`)
+	case "5121000000":
+		return http.StatusOK, json.RawMessage(`{"status":"ok","data":{"items":{
+			"city":[{"code":"5121010100","name":"Ананьїв","type":"city"}]}}}`)
+	case "8000000000":
+		return http.StatusOK, json.RawMessage(`{"status":"ok","data":{"items":{
+			"city-and-district":[{"code":"8036100000","name":"Голосіївський район","type":"city-and-district"}]}}}`)
+	}
+
+	return http.StatusNotFound, nil
+}
+
+func testKoatuuCrawler(client *OdbClient) *koatuuCrawler {
+	return &koatuuCrawler{
+		ctx:        context.Background(),
+		client:     client,
+		minBackoff: time.Millisecond,
+		requested:  map[string]bool{},
+	}
+}
+
+func TestCrawlKoatuuTree(t *testing.T) {
+	server := &koatuuServer{limited: map[string]int{"": 1, "8000000000": 2}}
+	tree, err := testKoatuuCrawler(newTestClient(t, server.handle)).crawl(0)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"", "", "5100000000", "5121000000", "5121010100", "8000000000", "8000000000", "8000000000", "8036100000"}
+
+	if !reflect.DeepEqual(server.requests, want) {
+		t.Errorf("requests %q, want %q", server.requests, want)
+	}
+
+	expected, _ := testKoatuuTree().Snapshot()
+	tree.Date = time.Time{}
+
+	if got, _ := tree.Snapshot(); string(got) != string(expected) {
+		t.Errorf("tree\n%s\nwant\n%s", got, expected)
+	}
+}
+
+func TestCrawlKoatuuTreeDepth(t *testing.T) {
+	server := &koatuuServer{}
+	tree, err := testKoatuuCrawler(newTestClient(t, server.handle)).crawl(1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"", "5100000000", "8000000000"}; !reflect.DeepEqual(server.requests, want) {
+		t.Errorf("requests %q, want %q", server.requests, want)
+	}
+
+	// districts of Odesa come with the city, the levels below are not requested
+	if tree.Len() != 6 || len(tree.Children("5121000000")) != 0 || len(tree.Children("5110100000")) != 1 {
+		t.Errorf("tree of %d nodes", tree.Len())
+	}
+}
+
+func TestCrawlKoatuuTreeGivesUp(t *testing.T) {
+	server := &koatuuServer{limited: map[string]int{"5100000000": koatuuRetries + 1}}
+	_, err := testKoatuuCrawler(newTestClient(t, server.handle)).crawl(0)
+
+	var apiErr *ApiError
+
+	if !errors.As(err, &apiErr) || !apiErr.IsRateLimited() || !strings.Contains(err.Error(), "5100000000") {
+		t.Fatalf("got %v", err)
+	}
+
+	if len(server.requests) != 1+koatuuRetries+1 {
+		t.Errorf("%d requests", len(server.requests))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err = newTestClient(t, server.handle).CrawlKoatuuTree(ctx, time.Millisecond, 0); !errors.Is(err, context.Canceled) {
+		t.Errorf("canceled crawl: %v", err)
+	}
+}
+
+func TestParseKoatuuCode(t *testing.T) {
+	for value, want := range map[string]string{
+		"5110136900":          "5110136900",
+		"51 101 369 00":       "5110136900",
+		"12080070010071470":   "12080070010071470",
+		"0000000000":          "",
+		"511013690":           "",
+		"UA12080070010071470": "",
+	} {
+		code, err := ParseKoatuuCode(value)
+
+		if code != want || (err == nil) != (want != "") {
+			t.Errorf("%q: got %q, %v", value, code, err)
+		}
+	}
+}
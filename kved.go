@@ -0,0 +1,319 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	_ "embed"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// kved2010Data is the КВЕД-2010 classifier (ДК 009:2010),
+// one "code<TAB>title" line per section, division, group and class, in classifier order
+//
+//go:embed kved2010.txt
+var kved2010Data string
+
+// KVEDLevel is a level of the classifier hierarchy
+type KVEDLevel int
+
+const (
+	KVEDSection  KVEDLevel = iota + 1 // Секція, літера A-U
+	KVEDDivision                      // Розділ, 62
+	KVEDGroup                         // Група, 62.0
+	KVEDClass                         // Клас, 62.01
+)
+
+// kvedSectionPattern matches a KVED section letter
+var kvedSectionPattern = regexp.MustCompile(`^[A-U]$`)
+
+// KVED is a kind of economic activity of the КВЕД-2010 classifier
+type KVED struct {
+	Code  string `json:"code"`  // Код: J, 62, 62.0 або 62.01
+	Title string `json:"title"` // Назва
+}
+
+type kvedNode struct {
+	kved     KVED
+	parent   string
+	children []string
+}
+
+var (
+	kvedOnce     sync.Once
+	kvedNodes    map[string]*kvedNode
+	kvedSections []string
+)
+
+// kvedTree
+// Classifier nodes by code, parsed from the embedded data on first use
+func kvedTree() map[string]*kvedNode {
+	kvedOnce.Do(func() {
+		kvedNodes = map[string]*kvedNode{}
+
+		var section string
+
+		for _, line := range strings.Split(kved2010Data, "\n") {
+			fields := strings.SplitN(strings.TrimSpace(line), "\t", 2)
+
+			if len(fields) != 2 {
+				continue
+			}
+
+			node := &kvedNode{kved: KVED{Code: fields[0], Title: fields[1]}}
+
+			switch node.kved.Level() {
+			case KVEDSection:
+				section = node.kved.Code
+				kvedSections = append(kvedSections, section)
+			case KVEDDivision:
+				node.parent = section
+			default:
+				node.parent = node.kved.Code[:len(node.kved.Code)-1]
+				node.parent = strings.TrimSuffix(node.parent, ".")
+			}
+
+			if parent, ok := kvedNodes[node.parent]; ok {
+				parent.children = append(parent.children, node.kved.Code)
+			}
+
+			kvedNodes[node.kved.Code] = node
+		}
+	})
+
+	return kvedNodes
+}
+
+// normalizeKVEDCode
+// Upper case code without spaces and the trailing dot, or an error
+// when it is neither a section letter nor a division, group or class code
+func normalizeKVEDCode(code string) (string, error) {
+	normalized := strings.TrimSuffix(strings.ToUpper(strings.TrimSpace(code)), ".")
+
+	if !kvedSectionPattern.MatchString(normalized) && !kvedCodePattern.MatchString(normalized) {
+		return "", fmt.Errorf("Invalid KVED code %q", code)
+	}
+
+	return normalized, nil
+}
+
+// ParseKVED
+// Parses an activity as the registries write it, e.g. "62.01 Комп'ютерне програмування".
+// The title is taken from the classifier when the value has only the code.
+func ParseKVED(value string) (KVED, error) {
+	fields := strings.Fields(value)
+
+	if len(fields) == 0 {
+		return KVED{}, fmt.Errorf("Invalid KVED %q", value)
+	}
+
+	code, err := normalizeKVEDCode(fields[0])
+
+	if err != nil {
+		return KVED{}, err
+	}
+
+	kved := KVED{Code: code, Title: strings.Join(fields[1:], " ")}
+
+	if kved.Title == "" {
+		if known, ok := LookupKVED(code); ok {
+			kved.Title = known.Title
+		}
+	}
+
+	return kved, nil
+}
+
+// LookupKVED
+// Classifier entry by code, false when the code is not in КВЕД-2010
+func LookupKVED(code string) (KVED, bool) {
+	normalized, err := normalizeKVEDCode(code)
+
+	if err != nil {
+		return KVED{}, false
+	}
+
+	node, ok := kvedTree()[normalized]
+
+	if !ok {
+		return KVED{}, false
+	}
+
+	return node.kved, true
+}
+
+// KVEDSections
+// All sections of the classifier from A to U
+func KVEDSections() []KVED {
+	tree := kvedTree()
+	sections := make([]KVED, 0, len(kvedSections))
+
+	for _, code := range kvedSections {
+		sections = append(sections, tree[code].kved)
+	}
+
+	return sections
+}
+
+// String
+// Form used by the registries: "62.01 Комп'ютерне програмування"
+func (k KVED) String() string {
+	return strings.TrimSpace(k.Code + " " + k.Title)
+}
+
+// Level
+// Level of the code in the hierarchy, 0 for an invalid code
+func (k KVED) Level() KVEDLevel {
+	switch {
+	case kvedSectionPattern.MatchString(k.Code):
+		return KVEDSection
+	case !kvedCodePattern.MatchString(k.Code):
+		return 0
+	case len(k.Code) == 2:
+		return KVEDDivision
+	case len(k.Code) == 4:
+		return KVEDGroup
+	}
+
+	return KVEDClass
+}
+
+// IsKnown
+// Reports whether the code is in КВЕД-2010
+func (k KVED) IsKnown() bool {
+	_, ok := kvedTree()[k.Code]
+
+	return ok
+}
+
+// Parent
+// Entry one level up, false for a section or an unknown code
+func (k KVED) Parent() (KVED, bool) {
+	tree := kvedTree()
+	node, ok := tree[k.Code]
+
+	if !ok || node.parent == "" {
+		return KVED{}, false
+	}
+
+	return tree[node.parent].kved, true
+}
+
+// Ancestors
+// Entries above the code from the section down to the parent,
+// e.g. J, 62, 62.0 for 62.01
+func (k KVED) Ancestors() (ancestors []KVED) {
+	for parent, ok := k.Parent(); ok; parent, ok = parent.Parent() {
+		ancestors = append([]KVED{parent}, ancestors...)
+	}
+
+	return ancestors
+}
+
+// Section
+// Section of the code, the code itself for a section
+func (k KVED) Section() (KVED, bool) {
+	if k.Level() == KVEDSection {
+		return LookupKVED(k.Code)
+	}
+
+	if ancestors := k.Ancestors(); len(ancestors) > 0 {
+		return ancestors[0], true
+	}
+
+	return KVED{}, false
+}
+
+// Children
+// Entries one level down in classifier order
+func (k KVED) Children() (children []KVED) {
+	tree := kvedTree()
+	node, ok := tree[k.Code]
+
+	if !ok {
+		return nil
+	}
+
+	for _, code := range node.children {
+		children = append(children, tree[code].kved)
+	}
+
+	return children
+}
+
+// Includes
+// Reports whether the other code is this one or lies below it, e.g. J includes 62.01
+func (k KVED) Includes(other KVED) bool {
+	if k.Code == other.Code {
+		return true
+	}
+
+	for _, ancestor := range other.Ancestors() {
+		if ancestor.Code == k.Code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SectionActivities
+// Division codes of a section for the activities filter of GetRegistrations,
+// e.g. 58, 59, 60, 61, 62, 63 for J
+func SectionActivities(section string) ([]string, error) {
+	kved, ok := LookupKVED(section)
+
+	if !ok || kved.Level() != KVEDSection {
+		return nil, fmt.Errorf("Unknown KVED section %q", section)
+	}
+
+	codes := []string{}
+
+	for _, division := range kved.Children() {
+		codes = append(codes, division.Code)
+	}
+
+	return codes, nil
+}
+
+// AddSection
+// Adds the divisions of a KVED section to Activities
+func (q *RegistrationsQuery) AddSection(section string) error {
+	codes, err := SectionActivities(section)
+
+	if err != nil {
+		return err
+	}
+
+	q.Activities = append(q.Activities, codes...)
+
+	return nil
+}
+
+// ParsedActivity
+// Main activity of the company as a KVED entry
+func (c *CompanyData) ParsedActivity() (KVED, error) {
+	return ParseKVED(c.Activities)
+}
+
+// ParsedActivity
+// Activity of the registered subject as a KVED entry
+func (r *RegistrationsItem) ParsedActivity() (KVED, error) {
+	return ParseKVED(r.Activity)
+}
+
+// ParsedActivity
+// Activity of the registered company as a KVED entry
+func (r *Registration) ParsedActivity() (KVED, error) {
+	return ParseKVED(r.Activity)
+}
+
+// KVED
+// Activity as a KVED entry
+func (a Activity) KVED() (KVED, error) {
+	return ParseKVED(a.Name)
+}
@@ -0,0 +1,131 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"reflect"
+	"testing"
+)
+
+func kvedCodes(entries []KVED) (codes []string) {
+	for _, entry := range entries {
+		codes = append(codes, entry.Code)
+	}
+
+	return codes
+}
+
+func TestKVEDHierarchy(t *testing.T) {
+	tests := []struct {
+		code      string
+		level     KVEDLevel
+		parent    string
+		ancestors []string
+		section   string
+	}{
+		{"J", KVEDSection, "", nil, "J"},
+		{"62", KVEDDivision, "J", []string{"J"}, "J"},
+		{"62.0", KVEDGroup, "62", []string{"J", "62"}, "J"},
+		{"62.01", KVEDClass, "62.0", []string{"J", "62", "62.0"}, "J"},
+		{"01.1", KVEDGroup, "01", []string{"A", "01"}, "A"},
+		{"69.10", KVEDClass, "69.1", []string{"M", "69", "69.1"}, "M"},
+	}
+
+	for _, test := range tests {
+		kved, ok := LookupKVED(test.code)
+
+		if !ok || !kved.IsKnown() || kved.Title == "" || kved.Level() != test.level {
+			t.Errorf("%s: %+v, level %d", test.code, kved, kved.Level())
+			continue
+		}
+
+		parent, ok := kved.Parent()
+
+		if parent.Code != test.parent || ok != (test.parent != "") {
+			t.Errorf("%s: parent %+v", test.code, parent)
+		}
+
+		if ancestors := kvedCodes(kved.Ancestors()); !reflect.DeepEqual(ancestors, test.ancestors) {
+			t.Errorf("%s: ancestors %v, want %v", test.code, ancestors, test.ancestors)
+		}
+
+		if section, ok := kved.Section(); !ok || section.Code != test.section {
+			t.Errorf("%s: section %+v", test.code, section)
+		}
+	}
+
+	unknown := KVED{Code: "62.99"}
+
+	if _, ok := unknown.Parent(); ok || unknown.IsKnown() || len(unknown.Ancestors()) != 0 {
+		t.Errorf("unknown code has a place in the tree")
+	}
+
+	if _, ok := unknown.Section(); ok {
+		t.Errorf("unknown code has a section")
+	}
+}
+
+func TestKVEDChildrenAndIncludes(t *testing.T) {
+	class, _ := LookupKVED("62.01")
+	section, _ := LookupKVED("j")
+
+	if children := kvedCodes(KVED{Code: "62.0"}.Children()); !reflect.DeepEqual(children, []string{"62.01", "62.02", "62.03", "62.09"}) {
+		t.Errorf("children %v", children)
+	}
+
+	if !section.Includes(class) || !class.Includes(class) || class.Includes(section) {
+		t.Error("J must include 62.01 and not the other way round")
+	}
+
+	if other, _ := LookupKVED("69.10"); section.Includes(other) {
+		t.Error("J includes 69.10")
+	}
+
+	divisions, err := SectionActivities("J")
+
+	if err != nil || !reflect.DeepEqual(divisions, []string{"58", "59", "60", "61", "62", "63"}) {
+		t.Errorf("divisions %v, %v", divisions, err)
+	}
+
+	if _, err = SectionActivities("62"); err == nil {
+		t.Error("division accepted as a section")
+	}
+
+	if sections := KVEDSections(); len(sections) != 21 || sections[0].Code != "A" || sections[20].Code != "U" {
+		t.Errorf("sections %v", kvedCodes(sections))
+	}
+}
+
+func TestParseKVED(t *testing.T) {
+	tests := []struct {
+		value string
+		want  KVED
+		fail  bool
+	}{
+		{value: "62.01 Комп'ютерне програмування", want: KVED{Code: "62.01", Title: "Комп'ютерне програмування"}},
+		{value: " 62.01 ", want: KVED{Code: "62.01", Title: "Комп'ютерне програмування"}},
+		{value: "69. Право", want: KVED{Code: "69", Title: "Право"}},
+		{value: "62.99", want: KVED{Code: "62.99"}},
+		{value: "", fail: true},
+		{value: "620.1 Програмування", fail: true},
+		{value: "Програмування", fail: true},
+	}
+
+	for _, test := range tests {
+		kved, err := ParseKVED(test.value)
+
+		if test.fail {
+			if err == nil {
+				t.Errorf("%q accepted as %+v", test.value, kved)
+			}
+
+			continue
+		}
+
+		if err != nil || kved != test.want {
+			t.Errorf("%q: got %+v, %v, want %+v", test.value, kved, err, test.want)
+		}
+	}
+}
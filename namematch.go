@@ -0,0 +1,311 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// NameMatcher scores candidate records against a person.
+// Name parts are compared by edit distance, in transliteration when one of them
+// is written in Latin, initials are expanded, and the birth date is checked when known.
+// A candidate whose last name is less similar than LastNameMin does not match at all.
+type NameMatcher struct {
+	LastNameMin     float64 // Мінімальна схожість прізвища
+	LastWeight      float64 // Вага прізвища
+	FirstWeight     float64 // Вага імені
+	MiddleWeight    float64 // Вага по батькові
+	BirthDateWeight float64 // Вага дати народження
+	InitialScore    float64 // Схожість ініціалу з повним ім'ям на ту ж літеру
+	UnknownScore    float64 // Схожість частини, відсутньої в одному з записів
+	MismatchFactor  float64 // Множник оцінки при розбіжності дат народження
+	MinScore        float64 // Мінімальна оцінка збігу
+}
+
+// DefaultNameMatcher
+// Matcher with the weights used by ScreenPerson
+func DefaultNameMatcher() *NameMatcher {
+	return &NameMatcher{
+		LastNameMin:     0.85,
+		LastWeight:      0.35,
+		FirstWeight:     0.25,
+		MiddleWeight:    0.15,
+		BirthDateWeight: 0.25,
+		InitialScore:    0.7,
+		UnknownScore:    0.5,
+		MismatchFactor:  0.4,
+		MinScore:        0.6,
+	}
+}
+
+// NameCandidate is a record to be matched
+type NameCandidate struct {
+	Name      string      // ПІБ у записі
+	BirthDate Date        // Дата народження у записі, якщо є
+	Record    interface{} // Запис джерела
+}
+
+// MatchBreakdown explains the score of a match, part scores are from 0 to 1
+type MatchBreakdown struct {
+	LastName   float64  `json:"last_name"`
+	FirstName  float64  `json:"first_name"`
+	MiddleName float64  `json:"middle_name"`
+	BirthDate  float64  `json:"birth_date"`
+	Notes      []string `json:"notes"` // Наприклад first_name_initial, last_name_translit, birth_date_mismatch
+}
+
+// NameMatch is a scored candidate
+type NameMatch struct {
+	Name      PersonName     `json:"name"`
+	BirthDate Date           `json:"birth_date"`
+	Score     float64        `json:"score"` // Оцінка від 0 до 1
+	Breakdown MatchBreakdown `json:"breakdown"`
+	Record    interface{}    `json:"record"`
+}
+
+// levenshtein
+// Edit distance between two strings in runes
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	result := values[0]
+
+	for _, value := range values[1:] {
+		if value < result {
+			result = value
+		}
+	}
+
+	return result
+}
+
+// similarity
+// 1 - edit distance relative to the longer string
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+
+	if longest == 0 {
+		return 1
+	}
+
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// foldNamePart
+// Lower case part without apostrophes and hyphens
+func foldNamePart(part string) string {
+	return strings.NewReplacer("'", "", "-", "", ".", "", " ", "").Replace(strings.ToLower(normalizeNamePart(part)))
+}
+
+// partScore
+// Similarity of two name parts and a note on how it was established
+func (m *NameMatcher) partScore(want, got string) (float64, string) {
+	if want == "" || got == "" {
+		return m.UnknownScore, "unknown"
+	}
+
+	a, b := foldNamePart(want), foldNamePart(got)
+
+	if a == "" || b == "" {
+		return m.UnknownScore, "unknown"
+	}
+
+	if a == b {
+		return 1, ""
+	}
+
+	if isInitial(want) || isInitial(got) {
+		la, lb := strings.ToLower(Transliterate(a)), strings.ToLower(Transliterate(b))
+
+		if []rune(a)[0] == []rune(b)[0] || (la != "" && lb != "" && la[0] == lb[0]) {
+			return m.InitialScore, "initial"
+		}
+
+		return 0, ""
+	}
+
+	if isLatinText(a) == isLatinText(b) {
+		return similarity(a, b), "fuzzy"
+	}
+
+	return similarity(strings.ToLower(Transliterate(a)), strings.ToLower(Transliterate(b))), "translit"
+}
+
+// isLatinText
+// Reports whether the text has Latin letters and no Cyrillic ones
+func isLatinText(text string) bool {
+	latin := false
+
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Cyrillic, r):
+			return false
+		case unicode.Is(unicode.Latin, r):
+			latin = true
+		}
+	}
+
+	return latin
+}
+
+// Score
+// Weighted score of the candidate with its breakdown.
+// A last name less similar than LastNameMin gives 0 whatever the other parts are.
+// An unknown birth date counts as UnknownScore, a different one
+// zeroes its part and multiplies the total by MismatchFactor.
+func (m *NameMatcher) Score(query PersonName, birthDate time.Time, candidate PersonName, candidateBirthDate time.Time) (float64, MatchBreakdown) {
+	var breakdown MatchBreakdown
+
+	parts := []struct {
+		want, got string
+		score     *float64
+		label     string
+	}{
+		{query.Last, candidate.Last, &breakdown.LastName, "last_name"},
+		{query.First, candidate.First, &breakdown.FirstName, "first_name"},
+		{query.Middle, candidate.Middle, &breakdown.MiddleName, "middle_name"},
+	}
+
+	for _, part := range parts {
+		score, note := m.partScore(part.want, part.got)
+		*part.score = score
+
+		if note == "translit" || (note != "" && score < 1) {
+			breakdown.Notes = append(breakdown.Notes, part.label+"_"+note)
+		}
+	}
+
+	if breakdown.LastName < m.LastNameMin {
+		breakdown.Notes = append(breakdown.Notes, "last_name_mismatch")
+
+		return 0, breakdown
+	}
+
+	mismatch := false
+
+	switch {
+	case birthDate.IsZero() || candidateBirthDate.IsZero():
+		breakdown.BirthDate = m.UnknownScore
+		breakdown.Notes = append(breakdown.Notes, "birth_date_unknown")
+	case sameDay(birthDate, candidateBirthDate):
+		breakdown.BirthDate = 1
+	default:
+		mismatch = true
+		breakdown.Notes = append(breakdown.Notes, "birth_date_mismatch")
+	}
+
+	total := m.LastWeight + m.FirstWeight + m.MiddleWeight + m.BirthDateWeight
+
+	if total == 0 {
+		return 0, breakdown
+	}
+
+	score := (m.LastWeight*breakdown.LastName +
+		m.FirstWeight*breakdown.FirstName +
+		m.MiddleWeight*breakdown.MiddleName +
+		m.BirthDateWeight*breakdown.BirthDate) / total
+
+	if mismatch {
+		score *= m.MismatchFactor
+	}
+
+	return score, breakdown
+}
+
+// Match
+// Scores the candidates and returns those not below MinScore, best first.
+// Candidates whose name cannot be parsed are skipped.
+func (m *NameMatcher) Match(query PersonName, birthDate time.Time, candidates []NameCandidate) (matches []NameMatch) {
+	for _, candidate := range candidates {
+		name, err := ParsePersonName(candidate.Name)
+
+		if err != nil {
+			continue
+		}
+
+		score, breakdown := m.Score(query, birthDate, name, candidate.BirthDate.Time)
+
+		if score < m.MinScore {
+			continue
+		}
+
+		matches = append(matches, NameMatch{
+			Name:      name,
+			BirthDate: candidate.BirthDate,
+			Score:     score,
+			Breakdown: breakdown,
+			Record:    candidate.Record,
+		})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	return matches
+}
+
+// Candidates
+// Wanted persons as match candidates
+func (w *Wanted) Candidates() (candidates []NameCandidate) {
+	for _, item := range w.Data.Items {
+		candidates = append(candidates, NameCandidate{Name: item.FullName, BirthDate: item.BirthDate, Record: item})
+	}
+
+	return candidates
+}
+
+// Candidates
+// Corrupt officials as match candidates
+func (c *CorruptOfficials) Candidates() (candidates []NameCandidate) {
+	for _, item := range c.Data.Items {
+		candidates = append(candidates, NameCandidate{Name: item.FullName, Record: item})
+	}
+
+	return candidates
+}
+
+// Candidates
+// Alimony debtors as match candidates
+func (a *AlimentData) Candidates() (candidates []NameCandidate) {
+	for _, item := range a.Aliments {
+		candidates = append(candidates, NameCandidate{Name: item.FullName, BirthDate: item.BirthDate, Record: item})
+	}
+
+	return candidates
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNameMatcherMatch(t *testing.T) {
+	birthDate := time.Date(1980, 5, 17, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		query              string
+		birthDate          time.Time
+		candidate          string
+		candidateBirthDate time.Time
+		match              bool
+	}{
+		// Common surnames with the same first name and patronymic
+		{"Петренко Іван Іванович", time.Time{}, "Шевченко Іван Іванович", time.Time{}, false},
+		{"Петренко Іван Іванович", time.Time{}, "Іваненко Іван Іванович", time.Time{}, false},
+		{"Петренко Іван Іванович", time.Time{}, "Коваленко Іван Іванович", time.Time{}, false},
+		{"Петренко Іван Іванович", time.Time{}, "Бойко Іван Іванович", time.Time{}, false},
+		{"Петренко Іван Іванович", birthDate, "Шевченко Іван Іванович", birthDate, false},
+		{"Петренко Іван Іванович", birthDate, "Петрук Іван Іванович", birthDate, false},
+		// Same person written differently
+		{"Петренко Іван Іванович", time.Time{}, "Петренко Іван Іванович", time.Time{}, true},
+		{"Петренко Іван Іванович", birthDate, "ПЕТРЕНКО ІВАН ІВАНОВИЧ", birthDate, true},
+		{"Петренко Іван Іванович", time.Time{}, "Petrenko Ivan Ivanovych", time.Time{}, true},
+		{"Петренко Іван Іванович", birthDate, "PETRENKO IVAN", birthDate, true},
+		{"Солов'як Юрій Ігорович", time.Time{}, "Soloviak Yurii Ihorovych", time.Time{}, true},
+		{"Петренко Іван Іванович", time.Time{}, "Петренко І. І.", time.Time{}, true},
+		{"Петренко Іван Іванович", birthDate, "І.І. Петренко", birthDate, true},
+		{"Петренко Іван Іванович", time.Time{}, "Пєтренко Іван Іванович", time.Time{}, true},
+		{"Петренко Іван Іванович", time.Time{}, "Іван Іванович Петренко", time.Time{}, true},
+	}
+
+	matcher := DefaultNameMatcher()
+
+	for _, test := range tests {
+		query, err := ParsePersonName(test.query)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		matches := matcher.Match(query, test.birthDate, []NameCandidate{
+			{Name: test.candidate, BirthDate: Date{Time: test.candidateBirthDate}},
+		})
+
+		if got := len(matches) > 0; got != test.match {
+			score, breakdown := matcher.Score(query, test.birthDate, mustParsePersonName(t, test.candidate), test.candidateBirthDate)
+			t.Errorf("%q vs %q: match %v, want %v (score %.3f, %+v)", test.query, test.candidate, got, test.match, score, breakdown)
+		}
+	}
+}
+
+func TestNameMatcherPartScore(t *testing.T) {
+	matcher := DefaultNameMatcher()
+
+	tests := []struct {
+		want, got string
+		score     float64
+		note      string
+	}{
+		{"Іван", "Іван", 1, ""},
+		{"Іван", "Ivan", 1, "translit"},
+		{"Олег", "Іван", 0, "fuzzy"},
+		{"Іван", "І.", matcher.InitialScore, "initial"},
+		{"Юрій", "Y.", matcher.InitialScore, "initial"},
+		{"Іван", "", matcher.UnknownScore, "unknown"},
+	}
+
+	for _, test := range tests {
+		score, note := matcher.partScore(test.want, test.got)
+
+		if score != test.score || note != test.note {
+			t.Errorf("partScore(%q, %q) = %.3f %q, want %.3f %q", test.want, test.got, score, note, test.score, test.note)
+		}
+	}
+}
+
+func mustParsePersonName(t *testing.T, value string) PersonName {
+	t.Helper()
+
+	name, err := ParsePersonName(value)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return name
+}
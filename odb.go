@@ -5,13 +5,13 @@
 package odb
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"time"
 )
 
 // Opendatabot — платформа для роботи з відкритими державними даними.
@@ -30,6 +30,7 @@ const (
 	governmentCompaniesEndpoint = "https://opendatabot.com/api/v2/government-companies"
 	dpaEndpoint                 = "https://opendatabot.com/api/v2/dpa/%s"
 	companyEndpoint             = "https://opendatabot.com/api/v2/company/%s"
+	fullCompanyEndpoint         = "https://opendatabot.com/api/v2/fullcompany/%s"
 	changesEndpoint             = "https://opendatabot.com/api/v2/changed/%s"
 	wagedebtEndpoint            = "https://opendatabot.com/api/v2/wagedebt/%s"
 	auditEndpoint               = "https://opendatabot.com/api/v2/audit"
@@ -40,6 +41,7 @@ const (
 	inspectionByIdEndpoint      = "https://opendatabot.com/api/v2/inspections/%s"
 	pdfEndpoint                 = "https://opendatabot.com/api/v2/pdf/%s"
 	permitsEndpoint             = "https://opendatabot.com/api/v2/permits"
+	gasStationsEndpoint         = "https://opendatabot.com/api/v2/gas-stations"
 	singletaxEndpoint           = "https://opendatabot.com/api/v2/singletax"
 	vatEndpoint                 = "https://opendatabot.com/api/v2/vat"
 	// Судовий реєстр
@@ -85,12 +87,29 @@ const (
 	realtyResultEndpoint         = "https://opendatabot.com/api/v2/realty-result"
 	realtyReportByNumberEndpoint = "https://opendatabot.com/api/v2/realty-report/%s"
 	// Моніторинг бізнесу
-	timelineEndpoint = "https://opendatabot.com/api/v2/timeline"
+	timelineEndpoint      = "https://opendatabot.com/api/v2/timeline"
+	historyEndpoint       = "https://opendatabot.com/api/v2/history"
+	subscriptionsEndpoint = "https://opendatabot.com/api/v2/subscriptions"
+	subscriptionEndpoint  = "https://opendatabot.com/api/v2/subscriptions/%s"
 )
 
 // OdbClient is the main Opendatabot struct of the package
 type OdbClient struct {
 	Settings *Settings
+	ctx      context.Context
+}
+
+// WithContext
+// Shallow copy of the client whose requests made with Do use ctx
+func (odb *OdbClient) WithContext(ctx context.Context) *OdbClient {
+	if ctx == nil {
+		panic("odb: nil context")
+	}
+
+	client := *odb
+	client.ctx = ctx
+
+	return &client
 }
 
 // Option is an option for OdbClient
@@ -114,6 +133,19 @@ func WithApiKey(apiKey string) Option {
 	return withApiKey(apiKey)
 }
 
+// HttpClient Option
+type withHttpClient struct {
+	client *http.Client
+}
+
+func (w withHttpClient) Apply(o *Settings) {
+	o.Client = w.client
+}
+
+func WithHttpClient(client *http.Client) Option {
+	return withHttpClient{client: client}
+}
+
 // NewOdbClient
 // Create new client
 func NewOdbClient(options ...Option) (*OdbClient, error) {
@@ -139,7 +171,7 @@ func ApplySettings(options []Option) (*Settings, error) {
 type GovernmentCompany struct {
 	Status string `json:"status"`
 	Data   struct {
-		Count int `json:"count"`
+		Count FlexInt `json:"count"`
 		Items []struct {
 			Code string `json:"code"`
 		} `json:"items"`
@@ -152,7 +184,7 @@ type GovernmentCompany struct {
 func (odb *OdbClient) GetGovernmentCompany(
 	code string, // Код ЄДРПОУ
 ) (response *GovernmentCompany, err error) {
-	if err = checkNotEmpty(code); err != nil {
+	if code, err = normalizeEdrpou(code); err != nil {
 		return nil, err
 	}
 
@@ -195,50 +227,46 @@ type FopDpa struct {
 	Status             string   `json:"status"`              // Статус
 	Phones             []string `json:"phones"`              // Телефони
 	Email              string   `json:"email"`               // Електронна пошта
-	RegistrationDate   string   `json:"registration_date"`   // Дата реєстрації
+	RegistrationDate   Date     `json:"registration_date"`   // Дата реєстрації
 	RegistrationNumber string   `json:"registration_number"` // Номер реєстрації
-	LastDate           string   `json:"last_date"`           // Дата оновлення інформації
-	BirthDate          string   `json:"birth_date"`          // Дата народження
+	LastDate           DateTime `json:"last_date"`           // Дата оновлення інформації
+	BirthDate          Date     `json:"birth_date"`          // Дата народження
 	// male
 	// female
-	Sex                    string   `json:"sex"`                     // Стать
-	Activities             string   `json:"activities"`              // Види діяльності https://tax.gov.ua/yuridichnim-osobam/arhiv/podatki-ta-zbori/ediniy-podatok/perelik-vidiv-diyalnosti---
-	AdditionallyActivities []string `json:"additionally_activities"` // Додаткові види діяльності
-	ActivityKinds          []struct {
-		Name      string `json:"name"` // Назва виду діяльності
-		Code      string `json:"code"` // Код виду діяльності
-		IsPrimary bool   `json:"is_primary"`
-	} `json:"activity_kinds"`
-	Registrations []struct {
-		EndDate     string `json:"end_date"`    // Дата зняття з обліку
+	Sex                    string         `json:"sex"`                     // Стать
+	Activities             string         `json:"activities"`              // Види діяльності https://tax.gov.ua/yuridichnim-osobam/arhiv/podatki-ta-zbori/ediniy-podatok/perelik-vidiv-diyalnosti---
+	AdditionallyActivities []string       `json:"additionally_activities"` // Додаткові види діяльності
+	ActivityKinds          []ActivityKind `json:"activity_kinds"`          // Всі види діяльності
+	Registrations          []struct {
+		EndDate     Date   `json:"end_date"`    // Дата зняття з обліку
 		Code        string `json:"code"`        // Ідентифікаційний код органу
 		Name        string `json:"name"`        // Назва органу
 		Description string `json:"description"` // Опис взяття на облік
 		Type        string `json:"type"`        // Тип взяття на облік
-		StartDate   string `json:"start_date"`  // Дата взяття на облік
+		StartDate   Date   `json:"start_date"`  // Дата взяття на облік
 	} `json:"registrations"` // Дані реєстраторів
 	Registration struct {
-		Date         string `json:"date"`          // Дата реєстрації
+		Date         Date   `json:"date"`          // Дата реєстрації
 		RecordNumber string `json:"record_number"` // Номер реєстрації
-		RecordDate   string `json:"record_date"`   // Дата запису
+		RecordDate   Date   `json:"record_date"`   // Дата запису
 	} `json:"registration"`
 	Termination struct {
 		State        int    `json:"state"`
 		StateText    string `json:"state_text"`
-		Date         string `json:"date"`
+		Date         Date   `json:"date"`
 		RecordNumber string `json:"record_number"` // Номер реєстрації
 		Cause        string `json:"cause"`         // Причина припинення
 	} `json:"termination"` // Статус припинення
 	TerminationCancel struct {
-		Date         string `json:"date"`
+		Date         Date   `json:"date"`
 		RecordNumber string `json:"record_number"` // Номер реєстрації
-		DocDate      string `json:"doc_date"`
+		DocDate      Date   `json:"doc_date"`
 		CourtName    string `json:"court_name"`
 		DocNumber    string `json:"doc_number"`
-		DateJudge    string `json:"date_judge"`
+		DateJudge    Date   `json:"date_judge"`
 	} `json:"termination_cancel"`
 	History []struct {
-		Date    string `json:"date"` // Дата внесення змін
+		Date    Date `json:"date"` // Дата внесення змін
 		Changes []struct {
 			// full_name
 			// ceo_name
@@ -253,15 +281,15 @@ type FopDpa struct {
 	TaxDebts  struct {
 		Text         string `json:"text"`          // Текстова інформація
 		Icon         string `json:"icon"`          // ⚠️
-		Total        string `json:"total"`         // Загальний податковий борг
-		Local        string `json:"local"`         // Місцевий податковий борг
-		Government   string `json:"government"`    // Державний податковий борг
-		DatabaseDate string `json:"database_date"` // Дата актуальності
+		Total        Money  `json:"total"`         // Загальний податковий борг
+		Local        Money  `json:"local"`         // Місцевий податковий борг
+		Government   Money  `json:"government"`    // Державний податковий борг
+		DatabaseDate Date   `json:"database_date"` // Дата актуальності
 		Type         string `json:"type"`          //
 	} `json:"tax_debts"`
 	Singletax struct {
-		DateStart string `json:"date_start"` // Дата відкриття єдиного податку
-		DateEnd   string `json:"date_end"`   // Дата закриття єдиного податку
+		DateStart Date   `json:"date_start"` // Дата відкриття єдиного податку
+		DateEnd   Date   `json:"date_end"`   // Дата закриття єдиного податку
 		Rate      string `json:"rate"`       // Відсоткова ставка єдиного податку
 		Group     string `json:"group"`      // Група податку
 		Active    bool   `json:"active"`     // Статус єдиного податку
@@ -270,21 +298,7 @@ type FopDpa struct {
 		Text string `json:"text"` // Текстова інформація
 		Icon string `json:"icon"` // ⚠️
 	} `json:"singletax_risk"` // Можлива втрата Єдиного податку
-	Address struct {
-		Zip     string `json:"zip"`
-		Country string `json:"country"`
-		Address string `json:"address"` // Адреса
-		Parts   struct {
-			Atu       string `json:"atu"`
-			AtuCode   string `json:"atu_code"`
-			Street    string `json:"street"`
-			HouseType string `json:"house_type"`
-			House     string `json:"house"`
-			Building  string `json:"building"`
-			NumType   string `json:"num_type"`
-			Num       string `json:"num"`
-		} `json:"parts"`
-	} `json:"address"` // Блок з адресою
+	Address        EdrAddress `json:"address"` // Блок з адресою
 	TaxDepartments struct {
 		TaxDepartmentId         int    `json:"tax_department_id"`
 		CREG                    int    `json:"C_REG"`
@@ -319,7 +333,7 @@ type FopDpa struct {
 func (odb *OdbClient) GetDpa(
 	code string, // індівідуальний код платника податків (ІПН)
 ) (response *FopDpa, err error) {
-	if err = checkNotEmpty(code); err != nil {
+	if code, err = normalizeRnokpp(code); err != nil {
 		return nil, err
 	}
 
@@ -479,12 +493,12 @@ type CompanyData struct {
 	Status        string `json:"status"`     // зареєстровано, зареєстровано, свідоцтво про державну реєстрацію недійсне, порушено справу про банкрутство, порушено справу про банкрутство (санація), в стані припинення, припинено
 	Beneficiaries []struct {
 		Title    string `json:"title"`    // ПІБ
-		Capital  int64  `json:"capital"`  // Капітал
+		Capital  Money  `json:"capital"`  // Капітал
 		Location string `json:"location"` // Адреса
 	} `json:"beneficiaries"`
-	DatabaseDate string `json:"database_date"` // Дата оновлення інформації
-	PdvCode      string `json:"pdv_code"`      // Код ПДВ
-	PdvStatus    string `json:"pdv_status"`    // Статус ПДВ
+	DatabaseDate DateTime `json:"database_date"` // Дата оновлення інформації
+	PdvCode      string   `json:"pdv_code"`      // Код ПДВ
+	PdvStatus    string   `json:"pdv_status"`    // Статус ПДВ
 }
 
 // GetCompany
@@ -496,7 +510,7 @@ type CompanyData struct {
 func (odb *OdbClient) GetCompany(
 	code string, // коди ЄДРПОУ
 ) (response []CompanyData, err error) {
-	if err = checkNotEmpty(code); err != nil {
+	if code, err = normalizeEdrpouList(code); err != nil {
 		return nil, err
 	}
 
@@ -539,7 +553,7 @@ func (odb *OdbClient) GetCompany(
 type ChangeData struct {
 	Code  string `json:"code"` // Код ЄДРПОУ
 	Items []struct {
-		Date    string `json:"date"` // Дата внесення змін
+		Date    Date `json:"date"` // Дата внесення змін
 		Changes []struct {
 			Field    string `json:"field"`     // Поле в якому відбулися зміни
 			OldValue string `json:"old_value"` // Старе значення
@@ -561,7 +575,7 @@ func (odb *OdbClient) GetChanges(
 	//	"from":	"дата, з якої показати зміни",
 	//}
 ) (response []ChangeData, err error) {
-	if err = checkNotEmpty(code); err != nil {
+	if code, err = normalizeEdrpouList(code); err != nil {
 		return nil, err
 	}
 
@@ -598,12 +612,12 @@ func (odb *OdbClient) GetChanges(
 }
 
 type Wagedebt struct {
-	Code           string `json:"code"`            // Код ЄДРПОУ
-	Debt           string `json:"debt"`            // Сумма заборгованості
-	PenaltiesCount string `json:"penalties_count"` // Кількість виконавчіх проваджень
-	Name           string `json:"name"`            // Повна назва компанії
-	DatabaseDate   string `json:"database_date"`   // Дата актуальності
-	Active         int    `json:"active"`          // Ознака актуальності
+	Code           string  `json:"code"`            // Код ЄДРПОУ
+	Debt           Money   `json:"debt"`            // Сумма заборгованості
+	PenaltiesCount FlexInt `json:"penalties_count"` // Кількість виконавчіх проваджень
+	Name           string  `json:"name"`            // Повна назва компанії
+	DatabaseDate   Date    `json:"database_date"`   // Дата актуальності
+	Active         int     `json:"active"`          // Ознака актуальності
 }
 
 // GetWagedebt
@@ -613,7 +627,7 @@ type Wagedebt struct {
 func (odb *OdbClient) GetWagedebt(
 	code string, // код ЄДРПОУ
 ) (response *Wagedebt, err error) {
-	if err = checkNotEmpty(code); err != nil {
+	if code, err = normalizeEdrpou(code); err != nil {
 		return nil, err
 	}
 
@@ -643,7 +657,7 @@ func (odb *OdbClient) GetWagedebt(
 type AuditsData struct {
 	AuditId string `json:"audit_id"` // Внутрішній id
 	Code    string `json:"code"`     // Код компанії/внутрішній id ФОПа
-	Date    string `json:"date"`     // Дата перевірки
+	Date    Date   `json:"date"`     // Дата перевірки
 	Type    string `json:"type"`     // Вид перевірки
 	Pib     string `json:"pib"`      // Ім'я ФОП
 }
@@ -716,15 +730,17 @@ func (odb *OdbClient) GetAuditById(
 }
 
 type Registrations struct {
-	Count int `json:"count"` // Кількість збігів
-	Items []struct {
-		Id               string `json:"id"`                // ідентифікатор запису
-		Type             string `json:"type"`              // Тип юридична (1) або фізична (2) особа
-		FullName         string `json:"full_name"`         // Повна назва компанії
-		Activity         string `json:"activity"`          // Види діяльності
-		RegistrationDate string `json:"registration_date"` // Дата реєстрації
-		RegionId         int    `json:"region_id"`         // ідентифікатор регіону
-	} `json:"items"`
+	Count int                 `json:"count"` // Кількість збігів
+	Items []RegistrationsItem `json:"items"`
+}
+
+type RegistrationsItem struct {
+	Id               string `json:"id"`                // ідентифікатор запису
+	Type             string `json:"type"`              // Тип юридична (1) або фізична (2) особа
+	FullName         string `json:"full_name"`         // Повна назва компанії
+	Activity         string `json:"activity"`          // Види діяльності
+	RegistrationDate Date   `json:"registration_date"` // Дата реєстрації
+	RegionId         int    `json:"region_id"`         // ідентифікатор регіону
 }
 
 // GetRegistrations
@@ -785,8 +801,8 @@ type Registration struct {
 	// в стані припинення, припинено
 	Email            string `json:"email"`             // Електронна пошта
 	Phones           string `json:"phones"`            // Телефони
-	RegistrationDate string `json:"registration_date"` // Дата реєстрації
-	Capital          string `json:"capital"`           // Капітал
+	RegistrationDate Date   `json:"registration_date"` // Дата реєстрації
+	Capital          Money  `json:"capital"`           // Капітал
 	Type             string `json:"type"`              // Тип юридична (1) або фізична (2) особа
 	RegionId         int    `json:"region_id"`         // Iдентифікатор регіону
 }
@@ -834,35 +850,37 @@ func (odb *OdbClient) GetRegistrationById(
 type InspectionsResponse struct {
 	Status string `json:"status"` // Статус операції
 	Data   struct {
-		Count int `json:"count"` // Кількість перевірок
-		Items []struct {
-			Id              string `json:"id"`               // ідентифікатор запису
-			Code            string `json:"code"`             // код ЄДРПОУ
-			Name            string `json:"name"`             // Перевіряючий орган
-			Address         string `json:"address"`          // Адреса
-			Region          string `json:"region"`           // Ідентифікатор регіону
-			Status          string `json:"status"`           // Статус перевірки
-			Risk            string `json:"risk"`             // Ризик
-			LastModify      string `json:"last_modify"`      // Час останьої модифікації
-			DateStart       string `json:"date_start"`       // Час початку перевірки
-			DateEnd         string `json:"date_end"`         // Час закінчення перевірки
-			Regulator       string `json:"regulator"`        // Регулятор
-			ParentRegulator string `json:"parent_regulator"` // Головне управління регулятора
-			ActivityType    string `json:"activity_type"`    // Ціль перевірки
-			DatabaseDate    string `json:"database_date"`    // Дата додання у базу
-			ViolationsCount string `json:"violations_count"` // Кількість порушень
-			PartsCount      string `json:"parts_count"`      // Кількість результатів переврок
-		} `json:"items"`
+		Count int          `json:"count"` // Кількість перевірок
+		Items []Inspection `json:"items"`
 	} `json:"data"`
 }
 
+type Inspection struct {
+	Id              string   `json:"id"`               // ідентифікатор запису
+	Code            string   `json:"code"`             // код ЄДРПОУ
+	Name            string   `json:"name"`             // Перевіряючий орган
+	Address         string   `json:"address"`          // Адреса
+	Region          string   `json:"region"`           // Ідентифікатор регіону
+	Status          string   `json:"status"`           // Статус перевірки
+	Risk            string   `json:"risk"`             // Ризик
+	LastModify      string   `json:"last_modify"`      // Час останьої модифікації
+	DateStart       DateTime `json:"date_start"`       // Час початку перевірки
+	DateEnd         DateTime `json:"date_end"`         // Час закінчення перевірки
+	Regulator       string   `json:"regulator"`        // Регулятор
+	ParentRegulator string   `json:"parent_regulator"` // Головне управління регулятора
+	ActivityType    string   `json:"activity_type"`    // Ціль перевірки
+	DatabaseDate    Date     `json:"database_date"`    // Дата додання у базу
+	ViolationsCount FlexInt  `json:"violations_count"` // Кількість порушень
+	PartsCount      FlexInt  `json:"parts_count"`      // Кількість результатів переврок
+}
+
 // GetInspections
 // Отримання інформації про перевірки
 // https://docs.opendatabot.com/#/%D0%9A%D0%BE%D0%BC%D0%BF%D0%B0%D0%BD%D1%96%D1%97%20%D1%82%D0%B0%20%D0%A4%D0%9E%D0%9F/inspections
 func (odb *OdbClient) GetInspections(
 	code string, // код ЄДРПОУ
 ) (response *InspectionsResponse, err error) {
-	if err = checkNotEmpty(code); err != nil {
+	if code, err = normalizeEdrpou(code); err != nil {
 		return nil, err
 	}
 
@@ -908,25 +926,8 @@ func (odb *OdbClient) GetInspections(
 }
 
 type InspectionItemResponse struct {
-	Status string `json:"status"` // Статус операції
-	Data   struct {
-		Id              string `json:"id"`               // ідентифікатор запису
-		Code            string `json:"code"`             // код ЄДРПОУ
-		Name            string `json:"name"`             // Перевіряючий орган
-		Address         string `json:"address"`          // Адреса
-		Region          string `json:"region"`           // Ідентифікатор регіону
-		Status          string `json:"status"`           // Статус перевірки
-		Risk            string `json:"risk"`             // Ризик
-		LastModify      string `json:"last_modify"`      // Час останьої модифікації
-		DateStart       string `json:"date_start"`       // Час початку перевірки
-		DateEnd         string `json:"date_end"`         // Час закінчення перевірки
-		Regulator       string `json:"regulator"`        // Регулятор
-		ParentRegulator string `json:"parent_regulator"` // Головне управління регулятора
-		ActivityType    string `json:"activity_type"`    // Ціль перевірки
-		DatabaseDate    string `json:"database_date"`    // Дата додання у базу
-		ViolationsCount string `json:"violations_count"` // Кількість порушень
-		PartsCount      string `json:"parts_count"`      // Кількість результатів переврок
-	} `json:"data"`
+	Status string     `json:"status"` // Статус операції
+	Data   Inspection `json:"data"`
 }
 
 // GetInspectionById
@@ -988,7 +989,7 @@ type Pdf struct {
 func (odb *OdbClient) GetPdf(
 	code string, // код ЄДРПОУ
 ) (response *Pdf, err error) {
-	if err = checkNotEmpty(code); err != nil {
+	if code, err = normalizeEdrpou(code); err != nil {
 		return nil, err
 	}
 
@@ -1016,31 +1017,33 @@ func (odb *OdbClient) GetPdf(
 type LicensesData struct {
 	Status string `json:"status"` // Статус запиту
 	Data   struct {
-		Count string `json:"count"` // Кількість знайдених об'єктів
-		Items []struct {
-			Number string `json:"number"` // Registration number
-			Type   string `json:"type"`
-			// Пальне
-			// Спирт
-			// Виробництво пального
-			// Зберігання пального
-			// Оптова торгівля пальним, за відсутності місць оптової торгівлі
-			// Оптова торгівля пальним, за наявності місць оптової торгівлі
-			// Роздрібна торгівля пальним
-			// Зберігання пального (виключно для потреб власного споживання чи промислової переробки)
-			Subtype          string `json:"subtype"`
-			StartDate        string `json:"start_date,omitempty"`
-			EndDate          string `json:"end_date,omitempty"`
-			RenewalDate      string `json:"renewal_date,omitempty"`
-			PauseDate        string `json:"pause_date,omitempty"`
-			CancelationDate  string `json:"cancelation_date,omitempty"`
-			Active           int    `json:"active"`
-			Address          string `json:"address,omitempty"`
-			RegistrationDate string `json:"registration_date,omitempty"`
-		} `json:"items"`
+		Count FlexInt  `json:"count"` // Кількість знайдених об'єктів
+		Items []Permit `json:"items"`
 	} `json:"data"`
 }
 
+type Permit struct {
+	Number FlexString  `json:"number"` // Registration number
+	Type   LicenseType `json:"type"`
+	// Пальне
+	// Спирт
+	// Виробництво пального
+	// Зберігання пального
+	// Оптова торгівля пальним, за відсутності місць оптової торгівлі
+	// Оптова торгівля пальним, за наявності місць оптової торгівлі
+	// Роздрібна торгівля пальним
+	// Зберігання пального (виключно для потреб власного споживання чи промислової переробки)
+	Subtype          string  `json:"subtype"`
+	StartDate        Date    `json:"start_date,omitempty"`
+	EndDate          Date    `json:"end_date,omitempty"`
+	RenewalDate      Date    `json:"renewal_date,omitempty"`
+	PauseDate        Date    `json:"pause_date,omitempty"`
+	CancelationDate  Date    `json:"cancelation_date,omitempty"`
+	Active           FlexInt `json:"active"`
+	Address          string  `json:"address,omitempty"`
+	RegistrationDate Date    `json:"registration_date,omitempty"`
+}
+
 // GetPermits
 // Отримати інформацію щодо ліцензій компанії
 // https://docs.opendatabot.com/#/%D0%9A%D0%BE%D0%BC%D0%BF%D0%B0%D0%BD%D1%96%D1%97%20%D1%82%D0%B0%20%D0%A4%D0%9E%D0%9F/licensesData
@@ -1050,6 +1053,10 @@ func (odb *OdbClient) GetPermits(
 	//	"pib":	"Статус ліцензії. Available values : 0, 1",
 	//}
 ) (response *LicensesData, err error) {
+	if params, err = normalizeCodeParam(params); err != nil {
+		return nil, err
+	}
+
 	if err = checkApiKey(odb); err != nil {
 		return nil, err
 	}
@@ -1093,13 +1100,13 @@ func (odb *OdbClient) GetPermits(
 type SingletaxSuccess struct {
 	Status string `json:"status"` // Статус запиту
 	Data   struct {
-		Count string `json:"count"` // Кількість знайдених об'єктів
+		Count FlexInt `json:"count"` // Кількість знайдених об'єктів
 		Items []struct {
 			FopHash   string `json:"fop_hash"`
 			Name      string `json:"name"`       // Назва компанії
 			Code      string `json:"code"`       // Код компанії
-			DateStart string `json:"date_start"` // Дата відкриття єдиного податку
-			DateEnd   string `json:"date_end"`   // Дата закриття єдиного податку
+			DateStart Date   `json:"date_start"` // Дата відкриття єдиного податку
+			DateEnd   Date   `json:"date_end"`   // Дата закриття єдиного податку
 			Rate      string `json:"rate"`       // Відсоткова ставка єдиного податку
 			Group     string `json:"group"`      // Група податку
 			Active    bool   `json:"active"`     // Статус єдиного податку
@@ -1117,6 +1124,10 @@ func (odb *OdbClient) GetSingletax(
 	//	"fophash": 	"Хеш фізичної особи",
 	//}
 ) (response *SingletaxSuccess, err error) {
+	if params, err = normalizeCodeParam(params); err != nil {
+		return nil, err
+	}
+
 	if err = checkApiKey(odb); err != nil {
 		return nil, err
 	}
@@ -1153,10 +1164,10 @@ type Vat struct {
 	Data   struct {
 		PdvCode      string `json:"pdv_code"`      // Код ПДВ
 		PdvStatus    string `json:"pdv_status"`    // Статус платника
-		DateAnul     string `json:"date_anul"`     // Дата анулювання> (якщо анульовано)
+		DateAnul     Date   `json:"date_anul"`     // Дата анулювання> (якщо анульовано)
 		Name         string `json:"name"`          // Повна назва компанії або ПІБ ФОП
 		Code         string `json:"code"`          // Код компанії (якщо компанія)
-		DatabaseDate string `json:"database_date"` // Дата оновлення інформації
+		DatabaseDate Date   `json:"database_date"` // Дата оновлення інформації
 	} `json:"data"`
 }
 
@@ -1216,15 +1227,15 @@ type CourtDecisions struct {
 		// Ухвала
 		// Окрема ухвала
 		// Окрема думка
-		JusticeName      string `json:"justice_name"`      // Тип процесуального документа
-		CategoryCode     int    `json:"category_code"`     // Внутрішній код категорії справи
-		CategoryName     string `json:"category_name"`     // Категорія справи
-		CauseNumber      string `json:"cause_number"`      // Номер справи
-		AdjudicationDate string `json:"adjudication_date"` // Дата набрання законної сили
-		DatePubl         string `json:"date_publ"`         // Дата публікації
-		ReceiptDate      string `json:"receipt_date"`      // Дата реєстрації
-		Judge            string `json:"judge"`             // Суддя
-		Link             string `json:"link"`              // Посилання на рішення
+		JusticeName      string   `json:"justice_name"`      // Тип процесуального документа
+		CategoryCode     int      `json:"category_code"`     // Внутрішній код категорії справи
+		CategoryName     string   `json:"category_name"`     // Категорія справи
+		CauseNumber      string   `json:"cause_number"`      // Номер справи
+		AdjudicationDate DateTime `json:"adjudication_date"` // Дата набрання законної сили
+		DatePubl         DateTime `json:"date_publ"`         // Дата публікації
+		ReceiptDate      DateTime `json:"receipt_date"`      // Дата реєстрації
+		Judge            string   `json:"judge"`             // Суддя
+		Link             string   `json:"link"`              // Посилання на рішення
 	} `json:"items"`
 }
 
@@ -1303,18 +1314,20 @@ func (odb *OdbClient) GetCourt(
 type Institution struct {
 	Status string `json:"status"` // Статус операції
 	Data   struct {
-		Count string `json:"count"` // Кількість знайдених судів
-		Items []struct {
-			Name     string `json:"name"`      // Найменування суду
-			CourtId  string `json:"court_id"`  // ID судової установі
-			Code     string `json:"code"`      // Код суду
-			RegionId string `json:"region_id"` // Номер регіону
-			Stage    string `json:"stage"`     // Інстанція
-			TypeId   string `json:"type_id"`   // Тип суду
-		} `json:"items"`
+		Count FlexInt           `json:"count"` // Кількість знайдених судів
+		Items []InstitutionItem `json:"items"`
 	} `json:"data"`
 }
 
+type InstitutionItem struct {
+	Name     string `json:"name"`      // Найменування суду
+	CourtId  string `json:"court_id"`  // ID судової установі
+	Code     string `json:"code"`      // Код суду
+	RegionId string `json:"region_id"` // Номер регіону
+	Stage    string `json:"stage"`     // Інстанція
+	TypeId   string `json:"type_id"`   // Тип суду
+}
+
 // GetInstitutions
 // Отримання судів
 // https://docs.opendatabot.com/#/%D0%A1%D1%83%D0%B4%D0%BE%D0%B2%D0%B8%D0%B9%20%D1%80%D0%B5%D1%94%D1%81%D1%82%D1%80/institutionsDictionary
@@ -1351,22 +1364,22 @@ func (odb *OdbClient) GetInstitutions(
 }
 
 type CourtItem struct {
-	DocId            int    `json:"doc_id"`            // Внутрішній id
-	CourtCode        int    `json:"court_code"`        // Внутрішній код судової установи
-	CourtName        string `json:"court_name"`        // Назва судової установи
-	JudgmentCode     int    `json:"judgment_code"`     // Внутрішній код Форми судочинства
-	JudgmentName     string `json:"judgment_name"`     // Форма судочинства
-	JusticeCode      int    `json:"justice_code"`      // Внутрішній код Типу процесуального документа
-	JusticeName      string `json:"justice_name"`      // Тип процесуального документа
-	CategoryCode     int    `json:"category_code"`     // Внутрішній код категорії справи
-	CategoryName     string `json:"category_name"`     // Категорія справи
-	CauseNumber      string `json:"cause_number"`      // Номер справи
-	AdjudicationDate string `json:"adjudication_date"` // Дата набрання законної сили
-	DatePubl         string `json:"date_publ"`         // Дата публікації
-	ReceiptDate      string `json:"receipt_date"`      // Дата реєстрації
-	Judge            string `json:"judge"`             // Суддя
-	DocumentLink     string `json:"document_link"`     // Посилання на докумен
-	Text             string `json:"text"`              // Текст документа
+	DocId            int      `json:"doc_id"`            // Внутрішній id
+	CourtCode        int      `json:"court_code"`        // Внутрішній код судової установи
+	CourtName        string   `json:"court_name"`        // Назва судової установи
+	JudgmentCode     int      `json:"judgment_code"`     // Внутрішній код Форми судочинства
+	JudgmentName     string   `json:"judgment_name"`     // Форма судочинства
+	JusticeCode      int      `json:"justice_code"`      // Внутрішній код Типу процесуального документа
+	JusticeName      string   `json:"justice_name"`      // Тип процесуального документа
+	CategoryCode     int      `json:"category_code"`     // Внутрішній код категорії справи
+	CategoryName     string   `json:"category_name"`     // Категорія справи
+	CauseNumber      string   `json:"cause_number"`      // Номер справи
+	AdjudicationDate DateTime `json:"adjudication_date"` // Дата набрання законної сили
+	DatePubl         DateTime `json:"date_publ"`         // Дата публікації
+	ReceiptDate      DateTime `json:"receipt_date"`      // Дата реєстрації
+	Judge            string   `json:"judge"`             // Суддя
+	DocumentLink     string   `json:"document_link"`     // Посилання на докумен
+	Text             string   `json:"text"`              // Текст документа
 }
 
 // GetCourtById
@@ -1420,7 +1433,7 @@ type Schedule struct {
 			CourtId      string   `json:"court_id"`      // id судової установи
 			Involved     string   `json:"involved"`      // Позивач/відповідач
 			Description  string   `json:"description"`   // Опис справи
-			Date         string   `json:"date"`          // Дата та час засідання
+			Date         DateTime `json:"date"`          // Дата та час засідання
 			JudgmentCode string   `json:"judgment_code"` // внутрішній код судочинства
 			Code         string   `json:"code"`          // Код суду
 			Accused      []string `json:"accused"`       // Список звинувачених
@@ -1511,18 +1524,20 @@ func (odb *OdbClient) GetSchedule(
 type Accused struct {
 	Status string `json:"status"` // Статус операції
 	Data   struct {
-		Count int `json:"count"` // Кількість збігів
-		Items []struct {
-			Forma        string   `json:"forma"`         // Форма судочинства
-			Number       string   `json:"number"`        // номер справи
-			CourtId      string   `json:"court_id"`      // id судової установи
-			Description  string   `json:"description"`   // Опис справи
-			JudgmentCode string   `json:"judgment_code"` // внутрішній код судочинства
-			Accused      []string `json:"accused"`       // Список звинувачених
-		} `json:"items"`
+		Count int           `json:"count"` // Кількість збігів
+		Items []AccusedItem `json:"items"`
 	} `json:"data"`
 }
 
+type AccusedItem struct {
+	Forma        string   `json:"forma"`         // Форма судочинства
+	Number       string   `json:"number"`        // номер справи
+	CourtId      string   `json:"court_id"`      // id судової установи
+	Description  string   `json:"description"`   // Опис справи
+	JudgmentCode string   `json:"judgment_code"` // внутрішній код судочинства
+	Accused      []string `json:"accused"`       // Список звинувачених
+}
+
 // GetAccused
 // Пошук осіб, які обвинувачюються у вчиненні кримінальних та адміністративних правопорушень
 // https://docs.opendatabot.com/#/%D0%A1%D1%83%D0%B4%D0%BE%D0%B2%D0%B8%D0%B9%20%D1%80%D0%B5%D1%94%D1%81%D1%82%D1%80/accused
@@ -1605,7 +1620,7 @@ type ScheduleItemMain struct {
 		CourtId      string   `json:"court_id"`      // id судової установи
 		Involved     string   `json:"involved"`      // Позивач/відповідач
 		Description  string   `json:"description"`   // Опис справи
-		Date         string   `json:"date"`          // Дата та час засідання
+		Date         DateTime `json:"date"`          // Дата та час засідання
 		JudgmentCode string   `json:"judgment_code"` // внутрішній код судочинства
 		Code         string   `json:"code"`          // Код суду
 		Accused      []string `json:"accused"`       // Список звинувачених
@@ -1653,24 +1668,24 @@ func (odb *OdbClient) GetScheduleById(
 
 type CompanyCourtsList struct {
 	Civil struct {
-		Count     string `json:"count"`      // Кількість виконавчіх проваджень
-		LiveCount string `json:"live_count"` // Кількість справ за якими заплановані засідання
+		Count     FlexInt `json:"count"`      // Кількість виконавчіх проваджень
+		LiveCount FlexInt `json:"live_count"` // Кількість справ за якими заплановані засідання
 	} `json:"civil"`
 	Criminal struct {
-		Count     string `json:"count"`      // Кількість виконавчіх проваджень
-		LiveCount string `json:"live_count"` // Кількість справ за якими заплановані засідання
+		Count     FlexInt `json:"count"`      // Кількість виконавчіх проваджень
+		LiveCount FlexInt `json:"live_count"` // Кількість справ за якими заплановані засідання
 	} `json:"criminal"`
 	Arbitrage struct {
-		Count     string `json:"count"`      // Кількість виконавчіх проваджень
-		LiveCount string `json:"live_count"` // Кількість справ за якими заплановані засідання
+		Count     FlexInt `json:"count"`      // Кількість виконавчіх проваджень
+		LiveCount FlexInt `json:"live_count"` // Кількість справ за якими заплановані засідання
 	} `json:"arbitrage"`
 	Administrative struct {
-		Count     string `json:"count"`      // Кількість виконавчіх проваджень
-		LiveCount string `json:"live_count"` // Кількість справ за якими заплановані засідання
+		Count     FlexInt `json:"count"`      // Кількість виконавчіх проваджень
+		LiveCount FlexInt `json:"live_count"` // Кількість справ за якими заплановані засідання
 	} `json:"administrative"`
 	AdminOffense struct {
-		Count     string `json:"count"`      // Кількість виконавчіх проваджень
-		LiveCount string `json:"live_count"` // Кількість справ за якими заплановані засідання
+		Count     FlexInt `json:"count"`      // Кількість виконавчіх проваджень
+		LiveCount FlexInt `json:"live_count"` // Кількість справ за якими заплановані засідання
 	} `json:"admin_offense"`
 }
 
@@ -1680,6 +1695,10 @@ type CompanyCourtsList struct {
 func (odb *OdbClient) GetCompanyCourts(
 	code string, // код ЄДРПОУ компанії
 ) (response *CompanyCourtsList, err error) {
+	if code, err = normalizeEdrpou(code); err != nil {
+		return nil, err
+	}
+
 	if err = checkApiKey(odb); err != nil {
 		return nil, err
 	}
@@ -1718,16 +1737,16 @@ func (odb *OdbClient) GetCompanyCourts(
 }
 
 type CompanyCourtsDetail struct {
-	Number           string `json:"number"`             // Номер
-	Date             string `json:"date"`               // Датa
-	DateStart        string `json:"date_start"`         // Датa
-	LastScheduleDate string `json:"last_schedule_date"` // Дата останнього засідання
-	Live             string `json:"live"`               // Ознака наявності засідань по справі в майбутньому
-	Description      string `json:"description"`        // Суть справи
-	ScheduleCount    string `json:"schedule_count"`     // Кількість засідань
-	Cost             string `json:"cost"`               // Сума спору
-	Amount           string `json:"amount"`             // Cума позовних вимог
-	CourtName        string `json:"court_name"`         // Назва суду
+	Number           string   `json:"number"`             // Номер
+	Date             Date     `json:"date"`               // Датa
+	DateStart        Date     `json:"date_start"`         // Датa
+	LastScheduleDate Date     `json:"last_schedule_date"` // Дата останнього засідання
+	Live             FlexBool `json:"live"`               // Ознака наявності засідань по справі в майбутньому
+	Description      string   `json:"description"`        // Суть справи
+	ScheduleCount    FlexInt  `json:"schedule_count"`     // Кількість засідань
+	Cost             Money    `json:"cost"`               // Сума спору
+	Amount           Money    `json:"amount"`             // Cума позовних вимог
+	CourtName        string   `json:"court_name"`         // Назва суду
 	Plaintiffs       []struct {
 		Code string `json:"code"` // Код ЄДРПОУ
 		Name string `json:"name"` // ПІБ
@@ -1749,7 +1768,7 @@ type CompanyCourtsDetail struct {
 		Name string `json:"name"` // ПІБ
 	} `json:"cassations"`
 	JudgmentCode     string `json:"judgment_code"`      // Код типу судочинства
-	LastDocumentDate string `json:"last_document_date"` // Дата останнього рішення
+	LastDocumentDate Date   `json:"last_document_date"` // Дата останнього рішення
 	Stages           struct {
 		First struct {
 			CourtCode            int    `json:"court_code"`             // Внутрішній код судової установи
@@ -1792,6 +1811,10 @@ func (odb *OdbClient) GetCompanyCourtsByType(
 	//	"date_to":		"Кінцева дата пошуку (Y-m-d)",
 	//}
 ) (response *CompanyCourtsDetail, err error) {
+	if code, err = normalizeEdrpou(code); err != nil {
+		return nil, err
+	}
+
 	if err = checkApiKey(odb); err != nil {
 		return nil, err
 	}
@@ -1877,17 +1900,17 @@ func (odb *OdbClient) GetCompanyCourtsByType(
 }
 
 type CompanyCourtsCases struct {
-	Number           string `json:"number"`             // Номер
-	Date             string `json:"date"`               // Дата
-	DateStart        string `json:"date_start"`         // Дата
-	LastScheduleDate string `json:"last_schedule_date"` // Дата останнього засідання
-	LastStatus       string `json:"last_status"`        // Поточний стан розгляду справи
-	Live             string `json:"live"`               // Ознака наявності засідань по справі в майбутньому
-	Description      string `json:"description"`        // Суть справи
-	ScheduleCount    string `json:"schedule_count"`     // Кількість засідань
-	Cost             string `json:"cost"`               // Сума спору
-	Amount           string `json:"amount"`             // Сума позовних вимог
-	CourtName        string `json:"court_name"`         // Назва суду
+	Number           string   `json:"number"`             // Номер
+	Date             Date     `json:"date"`               // Дата
+	DateStart        Date     `json:"date_start"`         // Дата
+	LastScheduleDate Date     `json:"last_schedule_date"` // Дата останнього засідання
+	LastStatus       string   `json:"last_status"`        // Поточний стан розгляду справи
+	Live             FlexBool `json:"live"`               // Ознака наявності засідань по справі в майбутньому
+	Description      string   `json:"description"`        // Суть справи
+	ScheduleCount    FlexInt  `json:"schedule_count"`     // Кількість засідань
+	Cost             Money    `json:"cost"`               // Сума спору
+	Amount           Money    `json:"amount"`             // Сума позовних вимог
+	CourtName        string   `json:"court_name"`         // Назва суду
 	Plaintiffs       []struct {
 		Code string `json:"code"` // Код ЄДРПОУ
 		Name string `json:"name"` // ПІБ
@@ -1909,7 +1932,7 @@ type CompanyCourtsCases struct {
 		Name string `json:"name"` // ПІБ
 	} `json:"cassations"`
 	JudgmentCode     string `json:"judgment_code"`      // Код типу судочинства
-	LastDocumentDate string `json:"last_document_date"` // Дата останнього рішення
+	LastDocumentDate Date   `json:"last_document_date"` // Дата останнього рішення
 	Stages           struct {
 		First struct {
 			CourtCode     int    `json:"court_code"`    // Внутрішній код судової установи
@@ -1935,13 +1958,13 @@ type CompanyCourtsCases struct {
 				//Ухвала
 				//Окрема ухвала
 				//Окрема думка
-				JusticeName      string `json:"justice_name"`      // Тип процесуального документа
-				AdjudicationDate string `json:"adjudication_date"` // Дата набрання законної сили
-				DatePubl         string `json:"date_publ"`         // Дата публікації
-				ReceiptDate      string `json:"receipt_date"`      // Дата реєстрації
-				Judge            string `json:"judge"`             // Суддя
-				Result           string `json:"result"`            // Результат
-				Link             string `json:"link"`              // Посилання на рішення
+				JusticeName      string   `json:"justice_name"`      // Тип процесуального документа
+				AdjudicationDate DateTime `json:"adjudication_date"` // Дата набрання законної сили
+				DatePubl         DateTime `json:"date_publ"`         // Дата публікації
+				ReceiptDate      DateTime `json:"receipt_date"`      // Дата реєстрації
+				Judge            string   `json:"judge"`             // Суддя
+				Result           string   `json:"result"`            // Результат
+				Link             string   `json:"link"`              // Посилання на рішення
 			} `json:"decisions"`
 		} `json:"first"`
 		Appeal struct {
@@ -1968,13 +1991,13 @@ type CompanyCourtsCases struct {
 				//Ухвала
 				//Окрема ухвала
 				//Окрема думка
-				JusticeName      string `json:"justice_name"`      // Тип процесуального документа
-				AdjudicationDate string `json:"adjudication_date"` // Дата набрання законної сили
-				DatePubl         string `json:"date_publ"`         // Дата публікації
-				ReceiptDate      string `json:"receipt_date"`      // Дата реєстрації
-				Judge            string `json:"judge"`             // Суддя
-				Result           string `json:"result"`            // Результат
-				Link             string `json:"link"`              // Посилання на рішення
+				JusticeName      string   `json:"justice_name"`      // Тип процесуального документа
+				AdjudicationDate DateTime `json:"adjudication_date"` // Дата набрання законної сили
+				DatePubl         DateTime `json:"date_publ"`         // Дата публікації
+				ReceiptDate      DateTime `json:"receipt_date"`      // Дата реєстрації
+				Judge            string   `json:"judge"`             // Суддя
+				Result           string   `json:"result"`            // Результат
+				Link             string   `json:"link"`              // Посилання на рішення
 			} `json:"decisions"`
 		} `json:"appeal"`
 		Cassation struct {
@@ -2001,13 +2024,13 @@ type CompanyCourtsCases struct {
 				//Ухвала
 				//Окрема ухвала
 				//Окрема думка
-				JusticeName      string `json:"justice_name"`      // Тип процесуального документа
-				AdjudicationDate string `json:"adjudication_date"` // Дата набрання законної сили
-				DatePubl         string `json:"date_publ"`         // Дата публікації
-				ReceiptDate      string `json:"receipt_date"`      // Дата реєстрації
-				Judge            string `json:"judge"`             // Суддя
-				Result           string `json:"result"`            // Результат
-				Link             string `json:"link"`              // Посилання на рішення
+				JusticeName      string   `json:"justice_name"`      // Тип процесуального документа
+				AdjudicationDate DateTime `json:"adjudication_date"` // Дата набрання законної сили
+				DatePubl         DateTime `json:"date_publ"`         // Дата публікації
+				ReceiptDate      DateTime `json:"receipt_date"`      // Дата реєстрації
+				Judge            string   `json:"judge"`             // Суддя
+				Result           string   `json:"result"`            // Результат
+				Link             string   `json:"link"`              // Посилання на рішення
 			} `json:"decisions"`
 		} `json:"cassation"`
 	} `json:"stages"`
@@ -2210,7 +2233,7 @@ type ItemFullTransport struct {
 	Number        string `json:"number"`       // Номер
 	Model         string `json:"model"`        // Модель
 	Year          string `json:"year"`         // Рік
-	Date          string `json:"date"`         // Дата реєстрації
+	Date          Date   `json:"date"`         // Дата реєстрації
 	Registration  string `json:"registration"` // Вид реєстрації
 	Capacity      int    `json:"capacity"`     // Об'єм двигуна
 	OwnerHash     string `json:"owner_hash"`   // Внутрішній id власника
@@ -2269,9 +2292,9 @@ type TransportLicenses struct {
 			Id               int    `json:"id"`                 // Внутрішній id
 			Number           string `json:"number"`             // Номер
 			LicenseStatus    string `json:"license_status"`     // Статус ліцензії
-			LicenseIssueDate string `json:"license_issue_date"` // дата випуску ліцензії
-			LicenseStartDate string `json:"license_start_date"` // дата початку ліцензії
-			LicenseEndDate   string `json:"license_end_date"`   // кінцева дата ліцензії
+			LicenseIssueDate Date   `json:"license_issue_date"` // дата випуску ліцензії
+			LicenseStartDate Date   `json:"license_start_date"` // дата початку ліцензії
+			LicenseEndDate   Date   `json:"license_end_date"`   // кінцева дата ліцензії
 			LicenseType      string `json:"license_type"`       // Тимчасовий реєстраційний талон
 		} `json:"items"`
 	} `json:"data"`
@@ -2327,9 +2350,9 @@ type ItemFullTransportLicenses struct {
 		CarrierName      string `json:"carrier_name"`       // Перевізник
 		OwnerHash        string `json:"owner_hash"`         // Внутрішній id власника
 		LicenseStatus    string `json:"license_status"`     // Статус ліцензії
-		LicenseIssueDate string `json:"license_issue_date"` // дата випуску ліцензії
-		LicenseStartDate string `json:"license_start_date"` // дата початку ліцензії
-		LicenseEndDate   string `json:"license_end_date"`   // кінцева дата ліцензії
+		LicenseIssueDate Date   `json:"license_issue_date"` // дата випуску ліцензії
+		LicenseStartDate Date   `json:"license_start_date"` // дата початку ліцензії
+		LicenseEndDate   Date   `json:"license_end_date"`   // кінцева дата ліцензії
 		LicenseType      string `json:"license_type"`       // Тимчасовий реєстраційний талон
 		TransportType    string `json:"transport_type"`     // тип транспорту
 		TransportStatus  string `json:"transport_status"`   // статус транспорту
@@ -2550,7 +2573,7 @@ type Statistics struct {
 		Limit   int    `json:"limit"`   // Кількість записів
 		Balance int    `json:"balance"` // Поточний баланс запитів
 	} `json:"STATISTICS"`
-	ExpiryDate string `json:"expiry_date"` // Дата закінчення пакету
+	ExpiryDate Date   `json:"expiry_date"` // Дата закінчення пакету
 	CustomerId string `json:"customerId"`  // ID клієнта
 	Webhook    string `json:"webhook"`     // Встановленний webhook
 }
@@ -2704,12 +2727,14 @@ func (odb *OdbClient) GetStatistics() (response *Statistics, err error) {
 }
 
 type AlimentData struct {
-	Count    int `json:"count"` // Кількість збігів
-	Aliments []struct {
-		FullName  string `json:"full_name"`  // Повне ім'я
-		BirthDate string `json:"birth_date"` // Дата народження
-		Active    int    `json:"active"`     // Ознака актуальності
-	} `json:"aliments"`
+	Count    int           `json:"count"` // Кількість збігів
+	Aliments []AlimentItem `json:"aliments"`
+}
+
+type AlimentItem struct {
+	FullName  string `json:"full_name"`  // Повне ім'я
+	BirthDate Date   `json:"birth_date"` // Дата народження
+	Active    int    `json:"active"`     // Ознака актуальності
 }
 
 // GetAliment
@@ -2751,19 +2776,21 @@ func (odb *OdbClient) GetAliment(
 type Lawyers struct {
 	Status string `json:"status"` // Статус операції
 	Data   struct {
-		Count int `json:"count"` // Кількість збігів
-		Items []struct {
-			Id           int    `json:"id"`            // Внутрішній id
-			FullName     string `json:"full_name"`     // ПІБ
-			Racalc       string `json:"racalc"`        // Обліковується у
-			Certnum      string `json:"certnum"`       // № Свідоцтва
-			Certat       string `json:"certat"`        // Дата видачі свідоцтва
-			Certcalc     string `json:"certcalc"`      // Орган, що видав свідоцтво
-			DatabaseDate string `json:"database_date"` // Дата актуальності
-		} `json:"items"`
+		Count int           `json:"count"` // Кількість збігів
+		Items []LawyersItem `json:"items"`
 	} `json:"data"`
 }
 
+type LawyersItem struct {
+	Id           int    `json:"id"`            // Внутрішній id
+	FullName     string `json:"full_name"`     // ПІБ
+	Racalc       string `json:"racalc"`        // Обліковується у
+	Certnum      string `json:"certnum"`       // № Свідоцтва
+	Certat       string `json:"certat"`        // Дата видачі свідоцтва
+	Certcalc     string `json:"certcalc"`      // Орган, що видав свідоцтво
+	DatabaseDate Date   `json:"database_date"` // Дата актуальності
+}
+
 // GetLawyers
 // Отримання переліку адвокатів
 // https://docs.opendatabot.com/#/%D0%A4%D1%96%D0%B7%D0%B8%D1%87%D0%BD%D1%96%20%D0%BE%D1%81%D0%BE%D0%B1%D0%B8/lawyers
@@ -2813,10 +2840,10 @@ type Lawyer struct {
 		Certnum        string `json:"certnum"`         // № Свідоцтва
 		Certat         string `json:"certat"`          // Дата видачі свідоцтва
 		Certcalc       string `json:"certcalc"`        // Орган, що видав свідоцтво
-		DatabaseDate   string `json:"database_date"`   // Дата актуальності
+		DatabaseDate   Date   `json:"database_date"`   // Дата актуальності
 		Phone          string `json:"phone"`           // Мобільний
 		Email          string `json:"email"`           // E-mail
-		DecisionDate   string `json:"decision_date"`   // Дата прийняття рішення
+		DecisionDate   Date   `json:"decision_date"`   // Дата прийняття рішення
 		DecisionNumber string `json:"decision_number"` // Номер рішення
 		Activities     string `json:"activities"`      // Форми адвокатської діяльності
 		Experience     string `json:"experience"`      // Загальний стаж адвоката
@@ -2869,7 +2896,7 @@ type CorruptOfficialsItem struct {
 	Data   struct {
 		Id             string   `json:"id"`              // ID
 		FullName       string   `json:"full_name"`       // Повне ім'я
-		DecisionDate   string   `json:"decision_date"`   // Дата судового рішення
+		DecisionDate   Date     `json:"decision_date"`   // Дата судового рішення
 		DecisionNumber string   `json:"decision_number"` // Номер судового рішення
 		WorkPlace      string   `json:"work_place"`      // Місце роботи на час вчинення корупційного правопорушення
 		Position       string   `json:"position"`        // Посада на час вчинення корупційного правопорушення
@@ -2917,20 +2944,22 @@ func (odb *OdbClient) GetCorruptOfficialsById(
 type CorruptOfficials struct {
 	Status string `json:"status"` // Статус операції
 	Data   struct {
-		Count int `json:"count"` // Кількість збігів
-		Items []struct {
-			Id             string   `json:"id"`              // ID
-			FullName       string   `json:"full_name"`       // Повне ім'я
-			DecisionDate   string   `json:"decision_date"`   // Дата судового рішення
-			DecisionNumber string   `json:"decision_number"` // Номер судового рішення
-			WorkPlace      string   `json:"work_place"`      // Місце роботи на час вчинення корупційного правопорушення
-			Position       string   `json:"position"`        // Посада на час вчинення корупційного правопорушення
-			CodexArticles  []string `json:"codex_articles"`  // Статті кодексів
-			Active         int      `json:"active"`          // Ознака актуальності
-		} `json:"items"`
+		Count int                   `json:"count"` // Кількість збігів
+		Items []CorruptOfficialItem `json:"items"`
 	} `json:"data"`
 }
 
+type CorruptOfficialItem struct {
+	Id             string   `json:"id"`              // ID
+	FullName       string   `json:"full_name"`       // Повне ім'я
+	DecisionDate   Date     `json:"decision_date"`   // Дата судового рішення
+	DecisionNumber string   `json:"decision_number"` // Номер судового рішення
+	WorkPlace      string   `json:"work_place"`      // Місце роботи на час вчинення корупційного правопорушення
+	Position       string   `json:"position"`        // Посада на час вчинення корупційного правопорушення
+	CodexArticles  []string `json:"codex_articles"`  // Статті кодексів
+	Active         int      `json:"active"`          // Ознака актуальності
+}
+
 // GetCorruptOfficials
 // Отримання відомостей про осіб, які вчинили корупційні правопорушення
 // https://docs.opendatabot.com/#/%D0%A4%D1%96%D0%B7%D0%B8%D1%87%D0%BD%D1%96%20%D0%BE%D1%81%D0%BE%D0%B1%D0%B8/corrupt-officials
@@ -2977,15 +3006,17 @@ func (odb *OdbClient) GetCorruptOfficials(
 }
 
 type Passport struct {
-	Count int `json:"count"` // Кількість збігів
-	Data  []struct {
-		Id        string `json:"id"`         // ID запису в МВС України
-		Number    string `json:"number"`     // Номер паспорта
-		Type      string `json:"type"`       // invalid, lost
-		Ovd       string `json:"ovd"`        // Регіон (орган внутрішніх справ)
-		TheftDate string `json:"theft_date"` // Дата внесення в базу
-		Date      string `json:"date"`       // Дата внесення змін
-	} `json:"data"`
+	Count int            `json:"count"` // Кількість збігів
+	Data  []PassportItem `json:"data"`
+}
+
+type PassportItem struct {
+	Id        string   `json:"id"`         // ID запису в МВС України
+	Number    string   `json:"number"`     // Номер паспорта
+	Type      string   `json:"type"`       // invalid, lost
+	Ovd       string   `json:"ovd"`        // Регіон (орган внутрішніх справ)
+	TheftDate DateTime `json:"theft_date"` // Дата внесення в базу
+	Date      DateTime `json:"date"`       // Дата внесення змін
 }
 
 // GetPassport
@@ -3025,24 +3056,26 @@ func (odb *OdbClient) GetPassport(
 type Wanted struct {
 	Status string `json:"status"` // Кількість збігів
 	Data   struct {
-		Count int `json:"count"` // Кількість збігів
-		Items []struct {
-			Id          string `json:"id"`           // Внутрішній ідентифікатор МВС
-			FullName    string `json:"full_name"`    // ім'я
-			BirthDate   string `json:"birth_date"`   // дата народження
-			LostDate    string `json:"lost_date"`    // Дата пошуку
-			Sex         string `json:"sex"`          // Стать
-			ArticleCrim string `json:"article_crim"` // звинувачення
-			LostPlace   string `json:"lost_place"`
-			Ovd         string `json:"ovd"` // розшукує
-			Category    string `json:"category"`
-			Restraint   string `json:"restraint"`   // Запобіжний захід
-			StatusText  string `json:"status_text"` // текст
-			Status      string `json:"status"`      // статус
-		} `json:"items"`
+		Count int          `json:"count"` // Кількість збігів
+		Items []WantedItem `json:"items"`
 	} `json:"data"`
 }
 
+type WantedItem struct {
+	Id          string `json:"id"`           // Внутрішній ідентифікатор МВС
+	FullName    string `json:"full_name"`    // ім'я
+	BirthDate   Date   `json:"birth_date"`   // дата народження
+	LostDate    Date   `json:"lost_date"`    // Дата пошуку
+	Sex         string `json:"sex"`          // Стать
+	ArticleCrim string `json:"article_crim"` // звинувачення
+	LostPlace   string `json:"lost_place"`
+	Ovd         string `json:"ovd"` // розшукує
+	Category    string `json:"category"`
+	Restraint   string `json:"restraint"`   // Запобіжний захід
+	StatusText  string `json:"status_text"` // текст
+	Status      string `json:"status"`      // статус
+}
+
 // GetWanted
 // Отримання інформації по базі людей в розшуку
 // https://docs.opendatabot.com/#/%D0%A4%D1%96%D0%B7%D0%B8%D1%87%D0%BD%D1%96%20%D0%BE%D1%81%D0%BE%D0%B1%D0%B8/wanted
@@ -3096,21 +3129,21 @@ type FullPenaltiesSuccess struct {
 		Count       int `json:"count"`        // Кількість збігів
 		ActiveCount int `json:"active_count"` // Кількість збігів
 		Items       []struct {
-			Number             string `json:"number"`               // Номер виконавчого провадження
-			BorrowerCode       string `json:"borrower_code"`        // код ЄДРПОУ
-			SubType            string `json:"sub_type"`             // Тип боржника
-			BorrowerLastName   string `json:"borrower_last_name"`   // Прізвище боржника
-			BorrowerFirstName  string `json:"borrower_first_name"`  // Ім'я боржника
-			BorrowerMiddleName string `json:"borrower_middle_name"` // По-батькові боржника
-			BorrowerBirthDate  string `json:"borrower_birth_date"`  // Дата народження боржника
-			CreditorName       string `json:"creditor_name"`        // Найменування стягувача
-			CreditorCode       string `json:"creditor_code"`        // Код ЄДРПОУ стягувача
-			CreditorSubType    string `json:"creditor_sub_type"`    // Тип стягувача
-			AsvpGisName        string `json:"asvp_gis_name"`        // Орган ДВС
-			AsvpDepId          string `json:"asvp_dep_id"`          // Ідентіфікаційний номер Органа ДВС
-			BeginDate          string `json:"begin_date"`           // Дата відкриття провадження
-			AsvpStatus         string `json:"asvp_status"`          // Статус провадження
-			Active             string `json:"active"`               // Код статусу провадження
+			Number             string   `json:"number"`               // Номер виконавчого провадження
+			BorrowerCode       string   `json:"borrower_code"`        // код ЄДРПОУ
+			SubType            string   `json:"sub_type"`             // Тип боржника
+			BorrowerLastName   string   `json:"borrower_last_name"`   // Прізвище боржника
+			BorrowerFirstName  string   `json:"borrower_first_name"`  // Ім'я боржника
+			BorrowerMiddleName string   `json:"borrower_middle_name"` // По-батькові боржника
+			BorrowerBirthDate  Date     `json:"borrower_birth_date"`  // Дата народження боржника
+			CreditorName       string   `json:"creditor_name"`        // Найменування стягувача
+			CreditorCode       string   `json:"creditor_code"`        // Код ЄДРПОУ стягувача
+			CreditorSubType    string   `json:"creditor_sub_type"`    // Тип стягувача
+			AsvpGisName        string   `json:"asvp_gis_name"`        // Орган ДВС
+			AsvpDepId          string   `json:"asvp_dep_id"`          // Ідентіфікаційний номер Органа ДВС
+			BeginDate          DateTime `json:"begin_date"`           // Дата відкриття провадження
+			AsvpStatus         string   `json:"asvp_status"`          // Статус провадження
+			Active             FlexInt  `json:"active"`               // Код статусу провадження
 		} `json:"items"`
 	} `json:"data"`
 }
@@ -3168,33 +3201,33 @@ func (odb *OdbClient) GetFullPenaltyByNumber(
 type FullPenaltiesSecretSuccess struct {
 	Status string `json:"status"` // Статус операції
 	Data   struct {
-		Number             string `json:"number"`               // Номер виконавчого провадження
-		BorrowerCode       string `json:"borrower_code"`        // код ЄДРПОУ
-		SubType            string `json:"sub_type"`             // Тип боржника
-		BorrowerLastName   string `json:"borrower_last_name"`   // Прізвище боржника
-		BorrowerFirstName  string `json:"borrower_first_name"`  // Ім'я боржника
-		BorrowerMiddleName string `json:"borrower_middle_name"` // По-батькові боржника
-		BorrowerBirthDate  string `json:"borrower_birth_date"`  // Дата народження боржника
-		CreditorName       string `json:"creditor_name"`        // Найменування стягувача
-		CreditorCode       string `json:"creditor_code"`        // Код ЄДРПОУ стягувача
-		CreditorSubType    string `json:"creditor_sub_type"`    // Тип стягувача
-		AsvpGisName        string `json:"asvp_gis_name"`        // Орган ДВС
-		AsvpDepId          string `json:"asvp_dep_id"`          // Ідентіфікаційний номер Органа ДВС
-		BeginDate          string `json:"begin_date"`           // Дата відкриття провадження
-		AsvpStatus         string `json:"asvp_status"`          // Статус провадження
-		Active             string `json:"active"`               // Код статусу провадження
-		State              string `json:"state"`                // Статус
-		ExecutorName       string `json:"executor_name"`        // П.І.Б виконавця
-		Publisher          string `json:"publisher"`            // Орган, який видав виконавчий документ
-		PublisherInfo      string `json:"publisher_info"`       // Дата та номер виконавчого документу
-		ExecutorAdress     string `json:"executor_adress"`      // Адреса виконавця
+		Number             string   `json:"number"`               // Номер виконавчого провадження
+		BorrowerCode       string   `json:"borrower_code"`        // код ЄДРПОУ
+		SubType            string   `json:"sub_type"`             // Тип боржника
+		BorrowerLastName   string   `json:"borrower_last_name"`   // Прізвище боржника
+		BorrowerFirstName  string   `json:"borrower_first_name"`  // Ім'я боржника
+		BorrowerMiddleName string   `json:"borrower_middle_name"` // По-батькові боржника
+		BorrowerBirthDate  Date     `json:"borrower_birth_date"`  // Дата народження боржника
+		CreditorName       string   `json:"creditor_name"`        // Найменування стягувача
+		CreditorCode       string   `json:"creditor_code"`        // Код ЄДРПОУ стягувача
+		CreditorSubType    string   `json:"creditor_sub_type"`    // Тип стягувача
+		AsvpGisName        string   `json:"asvp_gis_name"`        // Орган ДВС
+		AsvpDepId          string   `json:"asvp_dep_id"`          // Ідентіфікаційний номер Органа ДВС
+		BeginDate          DateTime `json:"begin_date"`           // Дата відкриття провадження
+		AsvpStatus         string   `json:"asvp_status"`          // Статус провадження
+		Active             FlexInt  `json:"active"`               // Код статусу провадження
+		State              string   `json:"state"`                // Статус
+		ExecutorName       string   `json:"executor_name"`        // П.І.Б виконавця
+		Publisher          string   `json:"publisher"`            // Орган, який видав виконавчий документ
+		PublisherInfo      string   `json:"publisher_info"`       // Дата та номер виконавчого документу
+		ExecutorAdress     string   `json:"executor_adress"`      // Адреса виконавця
 		Documents          []struct {
-			Id         string `json:"id"`          // Ідентифікаційний номер документа
-			Name       string `json:"name"`        // Назва документа
-			PrintDate  string `json:"print_date"`  // Дата публікації
-			AcceptDate string `json:"accept_date"` // Дата прийняття
-			CancelDate string `json:"cancel_date"` // Дата скасування документу
-			Link       string `json:"link"`        // Посилання на документ
+			Id         string   `json:"id"`          // Ідентифікаційний номер документа
+			Name       string   `json:"name"`        // Назва документа
+			PrintDate  DateTime `json:"print_date"`  // Дата публікації
+			AcceptDate DateTime `json:"accept_date"` // Дата прийняття
+			CancelDate Date     `json:"cancel_date"` // Дата скасування документу
+			Link       string   `json:"link"`        // Посилання на документ
 		} `json:"documents"`
 	} `json:"data"`
 }
@@ -3318,18 +3351,20 @@ func (odb *OdbClient) GetFullPenalty(
 type PerformerSuccess struct {
 	Status string `json:"status"`
 	Data   struct {
-		Count string `json:"count"`
-		Items []struct {
-			RegionId string `json:"regionId"`
-			Name     string `json:"name"`
-			Type     string `json:"type"`
-			Address  string `json:"address"`
-			Contacts string `json:"contacts"`
-			Managers string `json:"managers"`
-		} `json:"items"`
+		Count FlexInt         `json:"count"`
+		Items []PerformerItem `json:"items"`
 	} `json:"data"`
 }
 
+type PerformerItem struct {
+	RegionId string `json:"regionId"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Address  string `json:"address"`
+	Contacts string `json:"contacts"`
+	Managers string `json:"managers"`
+}
+
 // GetPerformer
 // Отримання інформації про державні та приватні виконавчі служби
 // https://docs.opendatabot.com/#/%D0%92%D0%B8%D0%BA%D0%BE%D0%BD%D0%B0%D0%B2%D1%87%D1%96%20%D0%BF%D1%80%D0%BE%D0%B2%D0%B0%D0%B4%D0%B6%D0%B5%D0%BD%D0%BD%D1%8F/performer
@@ -3400,33 +3435,35 @@ func (odb *OdbClient) GetPerformer(
 type PenaltiesSuccess struct {
 	Status string `json:"status"` // Статус операції
 	Data   struct {
-		Count int `json:"count"` // Кількість збігів
-		Items []struct {
-			Code             string    `json:"code"`                // код ЄДРПОУ
-			CourtName        string    `json:"court_name"`          // Документ виданий
-			GisName          string    `json:"gis_name"`            // Зв'язок з виконавцем
-			Number           string    `json:"number"`              // Номер виконавчого провадження
-			Category         string    `json:"category"`            // Категорія стягнення
-			Id               string    `json:"id"`                  // Ідентіфікаційний номер
-			Name             string    `json:"name"`                // Назва
-			AddressAtuStr    string    `json:"address_atu_str"`     // Адреса виконавця
-			Address          string    `json:"address"`             // Адреса виконавця
-			DepartmentPhone  string    `json:"department_phone"`    // Номер телефону виконавця
-			Executor         string    `json:"executor"`            // Виконавець
-			ExecutorPhone    string    `json:"executor_phone"`      // Номер телефону виконавця
-			ExecutorEmail    string    `json:"executor_email"`      // Email виконавця
-			DeductionType    string    `json:"deduction_type"`      // Категорія стягнення
-			LastName         string    `json:"last_name"`           // Прізвище боржника
-			FirstName        string    `json:"first_name"`          // Ім'я боржника
-			MiddleName       string    `json:"middle_name"`         // Ім'я по батькові боржника
-			BirthDate        time.Time `json:"birth_date"`          // Дата народження боржника
-			BirthPlaceAtuStr string    `json:"birth_place_atu_str"` // Місце народження боржника
-			BirthPlace       string    `json:"birth_place"`         // Адреса народження боржника
-			Link             string    `json:"link"`                // Посилання на додаткову інформацію
-		} `json:"items"`
+		Count int           `json:"count"` // Кількість збігів
+		Items []PenaltyItem `json:"items"`
 	} `json:"data"`
 }
 
+type PenaltyItem struct {
+	Code             string `json:"code"`                // код ЄДРПОУ
+	CourtName        string `json:"court_name"`          // Документ виданий
+	GisName          string `json:"gis_name"`            // Зв'язок з виконавцем
+	Number           string `json:"number"`              // Номер виконавчого провадження
+	Category         string `json:"category"`            // Категорія стягнення
+	Id               string `json:"id"`                  // Ідентіфікаційний номер
+	Name             string `json:"name"`                // Назва
+	AddressAtuStr    string `json:"address_atu_str"`     // Адреса виконавця
+	Address          string `json:"address"`             // Адреса виконавця
+	DepartmentPhone  string `json:"department_phone"`    // Номер телефону виконавця
+	Executor         string `json:"executor"`            // Виконавець
+	ExecutorPhone    string `json:"executor_phone"`      // Номер телефону виконавця
+	ExecutorEmail    string `json:"executor_email"`      // Email виконавця
+	DeductionType    string `json:"deduction_type"`      // Категорія стягнення
+	LastName         string `json:"last_name"`           // Прізвище боржника
+	FirstName        string `json:"first_name"`          // Ім'я боржника
+	MiddleName       string `json:"middle_name"`         // Ім'я по батькові боржника
+	BirthDate        Date   `json:"birth_date"`          // Дата народження боржника
+	BirthPlaceAtuStr string `json:"birth_place_atu_str"` // Місце народження боржника
+	BirthPlace       string `json:"birth_place"`         // Адреса народження боржника
+	Link             string `json:"link"`                // Посилання на додаткову інформацію
+}
+
 // GetPenaltiesByCode
 // Отримання інформації про актуальні виконавчі провадження компанії або приватної особи за кодом боржника
 // https://docs.opendatabot.com/#/%D0%92%D0%B8%D0%BA%D0%BE%D0%BD%D0%B0%D0%B2%D1%87%D1%96%20%D0%BF%D1%80%D0%BE%D0%B2%D0%B0%D0%B4%D0%B6%D0%B5%D0%BD%D0%BD%D1%8F/penalties
@@ -3466,6 +3503,10 @@ func (odb *OdbClient) GetPenaltiesByCode(
 	//	"limit":			"Кількість записів",
 	//}
 ) (response *PenaltiesSuccess, err error) {
+	if code, err = normalizeTaxCode(code); err != nil {
+		return nil, err
+	}
+
 	if err = checkApiKey(odb); err != nil {
 		return nil, err
 	}
@@ -3515,26 +3556,26 @@ func (odb *OdbClient) GetPenaltiesByCode(
 type PenaltySuccess struct {
 	Status string `json:"status"` // Статус операції
 	Data   struct {
-		Code             string    `json:"code"`                // код ЄДРПОУ
-		CourtName        string    `json:"court_name"`          // Документ виданий
-		GisName          string    `json:"gis_name"`            // Зв'язок з виконавцем
-		Number           string    `json:"number"`              // Номер виконавчого провадження
-		Category         string    `json:"category"`            // Категорія стягнення
-		Id               string    `json:"id"`                  // Ідентіфікаційний номер
-		Name             string    `json:"name"`                // Назва
-		AddressAtuStr    string    `json:"address_atu_str"`     // Адреса виконавця
-		Address          string    `json:"address"`             // Адреса виконавця
-		DepartmentPhone  string    `json:"department_phone"`    // Номер телефону виконавця
-		Executor         string    `json:"executor"`            // Виконавець
-		ExecutorPhone    string    `json:"executor_phone"`      // Номер телефону виконавця
-		ExecutorEmail    string    `json:"executor_email"`      // Email виконавця
-		DeductionType    string    `json:"deduction_type"`      // Категорія стягнення
-		LastName         string    `json:"last_name"`           // Прізвище боржника
-		FirstName        string    `json:"first_name"`          // Ім'я боржника
-		MiddleName       string    `json:"middle_name"`         // Ім'я по батькові боржника
-		BirthDate        time.Time `json:"birth_date"`          // Дата народження боржника
-		BirthPlaceAtuStr string    `json:"birth_place_atu_str"` // Місце народження боржника
-		BirthPlace       string    `json:"birth_place"`         // Адреса народження боржника
+		Code             string `json:"code"`                // код ЄДРПОУ
+		CourtName        string `json:"court_name"`          // Документ виданий
+		GisName          string `json:"gis_name"`            // Зв'язок з виконавцем
+		Number           string `json:"number"`              // Номер виконавчого провадження
+		Category         string `json:"category"`            // Категорія стягнення
+		Id               string `json:"id"`                  // Ідентіфікаційний номер
+		Name             string `json:"name"`                // Назва
+		AddressAtuStr    string `json:"address_atu_str"`     // Адреса виконавця
+		Address          string `json:"address"`             // Адреса виконавця
+		DepartmentPhone  string `json:"department_phone"`    // Номер телефону виконавця
+		Executor         string `json:"executor"`            // Виконавець
+		ExecutorPhone    string `json:"executor_phone"`      // Номер телефону виконавця
+		ExecutorEmail    string `json:"executor_email"`      // Email виконавця
+		DeductionType    string `json:"deduction_type"`      // Категорія стягнення
+		LastName         string `json:"last_name"`           // Прізвище боржника
+		FirstName        string `json:"first_name"`          // Ім'я боржника
+		MiddleName       string `json:"middle_name"`         // Ім'я по батькові боржника
+		BirthDate        Date   `json:"birth_date"`          // Дата народження боржника
+		BirthPlaceAtuStr string `json:"birth_place_atu_str"` // Місце народження боржника
+		BirthPlace       string `json:"birth_place"`         // Адреса народження боржника
 	} `json:"data"`
 }
 
@@ -3587,26 +3628,28 @@ func (odb *OdbClient) GetPenaltyByNumber(
 type PenaltyByFioSuccess struct {
 	Status string `json:"status"` // Статус операції
 	Data   struct {
-		Count int `json:"count"`
-		Items []struct {
-			CourtName       string    `json:"court_name"`       // Документ виданий
-			GisName         string    `json:"gis_name"`         // Зв'язок з виконавцем
-			Number          string    `json:"number"`           // Номер виконавчого провадження
-			Category        string    `json:"category"`         // Категорія стягнення
-			Id              string    `json:"id"`               // Ідентіфікаційний номер
-			DepartmentPhone string    `json:"department_phone"` // Номер телефону виконавця
-			Executor        string    `json:"executor"`         // Виконавець
-			ExecutorPhone   string    `json:"executor_phone"`   // Номер телефону виконавця
-			ExecutorEmail   string    `json:"executor_email"`   // Email виконавця
-			DeductionType   string    `json:"deduction_type"`   // Категорія стягнення
-			LastName        string    `json:"last_name"`        // Прізвище боржника
-			FirstName       string    `json:"first_name"`       // Ім'я боржника
-			MiddleName      string    `json:"middle_name"`      // Ім'я по батькові боржника
-			BirthDate       time.Time `json:"birth_date"`       // Дата народження боржника
-		} `json:"items"`
+		Count int                `json:"count"`
+		Items []PenaltyByFioItem `json:"items"`
 	} `json:"data"`
 }
 
+type PenaltyByFioItem struct {
+	CourtName       string `json:"court_name"`       // Документ виданий
+	GisName         string `json:"gis_name"`         // Зв'язок з виконавцем
+	Number          string `json:"number"`           // Номер виконавчого провадження
+	Category        string `json:"category"`         // Категорія стягнення
+	Id              string `json:"id"`               // Ідентіфікаційний номер
+	DepartmentPhone string `json:"department_phone"` // Номер телефону виконавця
+	Executor        string `json:"executor"`         // Виконавець
+	ExecutorPhone   string `json:"executor_phone"`   // Номер телефону виконавця
+	ExecutorEmail   string `json:"executor_email"`   // Email виконавця
+	DeductionType   string `json:"deduction_type"`   // Категорія стягнення
+	LastName        string `json:"last_name"`        // Прізвище боржника
+	FirstName       string `json:"first_name"`       // Ім'я боржника
+	MiddleName      string `json:"middle_name"`      // Ім'я по батькові боржника
+	BirthDate       Date   `json:"birth_date"`       // Дата народження боржника
+}
+
 // GetPenalties
 // Отримання інформації про актуальні виконавчі провадження приватної особи за ПІБ
 // https://docs.opendatabot.com/#/%D0%92%D0%B8%D0%BA%D0%BE%D0%BD%D0%B0%D0%B2%D1%87%D1%96%20%D0%BF%D1%80%D0%BE%D0%B2%D0%B0%D0%B4%D0%B6%D0%B5%D0%BD%D0%BD%D1%8F/penaltiesByFioAndBirth
@@ -3887,6 +3930,10 @@ type Koatuu struct {
 func (odb *OdbClient) GetKoatuuRegionsByCode(
 	code string, // КОАТУУ код (10 або 17 цифр)
 ) (response *Koatuu, err error) {
+	if code, err = ParseKoatuuCode(code); err != nil {
+		return nil, err
+	}
+
 	endpoint := fmt.Sprintf(koatuuRegionsByCodeEndpoint, code)
 
 	err = odb.Do(endpoint, map[string]string{}, &response)
@@ -4111,17 +4158,19 @@ func (odb *OdbClient) GetKoatuuRegionsByCode(
 	//}
 }
 
+type RealtyListItem struct {
+	DcGroupType string `json:"dcGroupType"`
+	Name        string `json:"name"` // Адреса нерухомості
+	Id          string `json:"id"`   // ID об'єкту нерухомості
+	Link        string `json:"link"` // Посилання на повний об'єкт нерухомості
+}
+
 type RealtySuccess struct {
 	Status string `json:"status"` // Статус запиту
 	Data   struct {
-		Count          string `json:"count"` // Кількість знайдениї об'єктів нерухомості
-		ReportResultId string `json:"reportResultId"`
-		Items          []struct {
-			DcGroupType string `json:"dcGroupType"`
-			Name        string `json:"name"` // Адреса нерухомості
-			Id          string `json:"id"`   // ID об'єкту нерухомості
-			Link        string `json:"link"` // Посилання на повний об'єкт нерухомості
-		} `json:"items"`
+		Count          FlexInt          `json:"count"` // Кількість знайдениї об'єктів нерухомості
+		ReportResultId string           `json:"reportResultId"`
+		Items          []RealtyListItem `json:"items"`
 	} `json:"data"`
 }
 
@@ -4157,6 +4206,10 @@ func (odb *OdbClient) GetRealty(
 	//	//25 - Довірчій власник
 	//}
 ) (response *RealtySuccess, err error) {
+	if code, err = normalizeTaxCode(code); err != nil {
+		return nil, err
+	}
+
 	if err = checkApiKey(odb); err != nil {
 		return nil, err
 	}
@@ -4227,19 +4280,22 @@ func (odb *OdbClient) GetRealtyById(
 type RealtyResultSuccess struct {
 	Status string `json:"status"` // Статус запиту
 	Data   struct {
-		Data struct {
-			Realty            string `json:"realty"`            // Актуальна інформація про нерухоміть
-			OldMortgageJson   string `json:"oldMortgageJson"`   // Інформація про іпотеку(до 2013р)
-			OldLimitationJson string `json:"oldLimitationJson"` // Інформація про обтяження(до 2013р)
-			OldRealty         string `json:"oldRealty"`         // Інформація про нерухомість(до 2013р)
-			AllAdresses       string `json:"allAdresses"`       // Інші адреса
-		} `json:"data"`
-		Status  string `json:"status"`   // Статус обробки запиту
-		PdfLink string `json:"pdf_link"` // Посилання на PDF документ
-		Fixed   string `json:"fixed"`    // Статус виправлення PDF документу
+		Data    RealtyPayloads `json:"data"`
+		Status  string         `json:"status"`   // Статус обробки запиту
+		PdfLink string         `json:"pdf_link"` // Посилання на PDF документ
+		Fixed   FlexBool       `json:"fixed"`    // Статус виправлення PDF документу
 	} `json:"data"`
 }
 
+// RealtyPayloads are the extract data sent as JSON strings or as JSON, see Decode
+type RealtyPayloads struct {
+	Realty            RealtyPayload `json:"realty"`            // Актуальна інформація про нерухоміть
+	OldMortgageJson   RealtyPayload `json:"oldMortgageJson"`   // Інформація про іпотеку(до 2013р)
+	OldLimitationJson RealtyPayload `json:"oldLimitationJson"` // Інформація про обтяження(до 2013р)
+	OldRealty         RealtyPayload `json:"oldRealty"`         // Інформація про нерухомість(до 2013р)
+	AllAdresses       RealtyPayload `json:"allAdresses"`       // Інші адреса
+}
+
 // GetRealtyResult
 // Отримання витягу або поточного статусу його формування по об’єкту нерухомості або земельній ділянці
 // https://docs.opendatabot.com/#/%D0%9D%D0%B5%D1%80%D1%83%D1%85%D0%BE%D0%BC%D1%96%D1%81%D1%82%D1%8C/realty-result
@@ -4315,50 +4371,54 @@ func (odb *OdbClient) GetRealtyReportByNumber(
 type Timeline struct {
 	Status string `json:"status"`
 	Data   struct {
-		Count int `json:"count"`
-		Items []struct {
-			LogId     string    `json:"log_id"`
-			Id        string    `json:"id"`
-			Code      string    `json:"code"`
-			Type      string    `json:"type"`
-			CreatedAt time.Time `json:"created_at"`
-			EventDate time.Time `json:"event_date"`
-			Change    []struct {
-				OldValue          string   `json:"old_value,omitempty"`
-				NewValue          string   `json:"new_value,omitempty"`
-				Number            string   `json:"number,omitempty"`
-				DocumentId        string   `json:"document_id,omitempty"`
-				CountAddedItems   string   `json:"countAddedItems,omitempty"`
-				AddedItems        []string `json:"addedItems,omitempty"`
-				CountRemovedItems string   `json:"countRemovedItems,omitempty"`
-				RemovedItems      string   `json:"removedItems,omitempty"`
-				Date              string   `json:"date,omitempty"`
-				Name              string   `json:"name,omitempty"`
-				IsCompany         string   `json:"is_company,omitempty"`
-				JudgmentCode      string   `json:"judgment_code,omitempty"`
-				Source            string   `json:"source,omitempty"`
-				Link              string   `json:"link,omitempty"`
-				CompanyName       string   `json:"company_name,omitempty"`
-				WithoutChangeLogs string   `json:"without_change_logs,omitempty"`
-				DeclarantId       string   `json:"declarant_id,omitempty"`
-				Year              string   `json:"year,omitempty"`
-				DeclarationId     string   `json:"declaration_id,omitempty"`
-				PublicType        string   `json:"public_type,omitempty"`
-				SubjectType       string   `json:"subject_type,omitempty"`
-				CodePdv           string   `json:"code_pdv,omitempty"`
-				EventDate         string   `json:"eventDate,omitempty"`
-				StartDate         string   `json:"startDate,omitempty"`
-				EndDate           string   `json:"endDate,omitempty"`
-				Termless          string   `json:"termless,omitempty"`
-				SanctionList      string   `json:"sanctionList,omitempty"`
-				SanctionReason    string   `json:"sanctionReason,omitempty"`
-				Pib               string   `json:"pib,omitempty"`
-				Resident          string   `json:"resident,omitempty"`
-			} `json:"change"`
-		} `json:"items"`
+		Count int            `json:"count"`
+		Items []TimelineItem `json:"items"`
 	} `json:"data"`
 }
 
+type TimelineItem struct {
+	LogId     string           `json:"log_id"`
+	Id        string           `json:"id"`
+	Code      string           `json:"code"`
+	Type      string           `json:"type"`
+	CreatedAt DateTime         `json:"created_at"`
+	EventDate DateTime         `json:"event_date"`
+	Change    []TimelineChange `json:"change"`
+}
+
+type TimelineChange struct {
+	OldValue          string   `json:"old_value,omitempty"`
+	NewValue          string   `json:"new_value,omitempty"`
+	Number            string   `json:"number,omitempty"`
+	DocumentId        string   `json:"document_id,omitempty"`
+	CountAddedItems   string   `json:"countAddedItems,omitempty"`
+	AddedItems        []string `json:"addedItems,omitempty"`
+	CountRemovedItems FlexInt  `json:"countRemovedItems,omitempty"`
+	RemovedItems      string   `json:"removedItems,omitempty"`
+	Date              Date     `json:"date,omitempty"`
+	Name              string   `json:"name,omitempty"`
+	IsCompany         FlexBool `json:"is_company,omitempty"`
+	JudgmentCode      string   `json:"judgment_code,omitempty"`
+	Source            string   `json:"source,omitempty"`
+	Link              string   `json:"link,omitempty"`
+	CompanyName       string   `json:"company_name,omitempty"`
+	WithoutChangeLogs string   `json:"without_change_logs,omitempty"`
+	DeclarantId       string   `json:"declarant_id,omitempty"`
+	Year              string   `json:"year,omitempty"`
+	DeclarationId     string   `json:"declaration_id,omitempty"`
+	PublicType        string   `json:"public_type,omitempty"`
+	SubjectType       string   `json:"subject_type,omitempty"`
+	CodePdv           string   `json:"code_pdv,omitempty"`
+	EventDate         DateTime `json:"eventDate,omitempty"`
+	StartDate         Date     `json:"startDate,omitempty"`
+	EndDate           Date     `json:"endDate,omitempty"`
+	Termless          FlexBool `json:"termless,omitempty"`
+	SanctionList      string   `json:"sanctionList,omitempty"`
+	SanctionReason    string   `json:"sanctionReason,omitempty"`
+	Pib               string   `json:"pib,omitempty"`
+	Resident          string   `json:"resident,omitempty"`
+}
+
 // GetTimeline
 // Отримання стрічки змін за реєстрами
 // https://docs.opendatabot.com/#/%D0%9C%D0%BE%D0%BD%D1%96%D1%82%D0%BE%D1%80%D0%B8%D0%BD%D0%B3%20%D0%B1%D1%96%D0%B7%D0%BD%D0%B5%D1%81%D1%83/timeline
@@ -4624,6 +4684,25 @@ func checkNotEmpty(id string) error {
 	return nil
 }
 
+func checkKnownParams(params map[string]string, known []string) error {
+	for key := range params {
+		found := key == "apiKey"
+
+		for _, name := range known {
+			if key == name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("Unknown parameter %s", key)
+		}
+	}
+
+	return nil
+}
+
 func buildQueryParams(endpoint string, params map[string]string) (uri string, err error) {
 	base, err := url.Parse(endpoint)
 
@@ -4642,9 +4721,47 @@ func buildQueryParams(endpoint string, params map[string]string) (uri string, er
 	return base.String(), err
 }
 
+// ApiError
+// Returned when Opendatabot responds with a non-200 status code
+type ApiError struct {
+	StatusCode int
+}
+
+func (e *ApiError) Error() string {
+	return http.StatusText(e.StatusCode)
+}
+
+// IsRateLimited
+// Reports whether the request was rejected because of too many requests
+func (e *ApiError) IsRateLimited() bool {
+	return e.StatusCode == http.StatusTooManyRequests
+}
+
+// IsNotFound
+// Reports whether the requested record does not exist
+func (e *ApiError) IsNotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
 // Do
 // Make Request
 func (odb *OdbClient) Do(endpoint string, params map[string]string, v interface{}) (err error) {
+	ctx := odb.ctx
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return odb.DoContext(ctx, http.MethodGet, endpoint, params, v)
+}
+
+// DoContext
+// Make Request with the given context and HTTP method
+func (odb *OdbClient) DoContext(ctx context.Context, method string, endpoint string, params map[string]string, v interface{}) (err error) {
+	if params == nil {
+		params = map[string]string{}
+	}
+
 	if odb.Settings.ApiKey != "" {
 		params["apiKey"] = odb.Settings.ApiKey
 	}
@@ -4655,24 +4772,40 @@ func (odb *OdbClient) Do(endpoint string, params map[string]string, v interface{
 		return err
 	}
 
-	resp, err := http.Get(endpointWithParams)
+	req, err := http.NewRequestWithContext(ctx, method, endpointWithParams, nil)
 
 	if err != nil {
 		return err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return errors.New(http.StatusText(resp.StatusCode))
+	client := odb.Settings.Client
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return err
 	}
 
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		return &ApiError{StatusCode: resp.StatusCode}
+	}
+
 	body, err := ioutil.ReadAll(resp.Body)
 
 	if err != nil {
 		return err
 	}
 
+	if v == nil {
+		return nil
+	}
+
 	err = json.Unmarshal(body, &v)
 
 	if err != nil {
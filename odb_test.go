@@ -0,0 +1,55 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+// roundTripFunc serves requests of a test client without network
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newTestClient
+// Client whose requests are answered by handler
+func newTestClient(t *testing.T, handler func(req *http.Request) (int, interface{})) *OdbClient {
+	t.Helper()
+
+	client, err := NewOdbClient(
+		WithApiKey("test"),
+		WithHttpClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			if err := req.Context().Err(); err != nil {
+				return nil, err
+			}
+
+			status, body := handler(req)
+			data, err := json.Marshal(body)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			return &http.Response{
+				StatusCode: status,
+				Body:       ioutil.NopCloser(bytes.NewReader(data)),
+				Header:     http.Header{},
+				Request:    req,
+			}, nil
+		})}),
+	)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
@@ -0,0 +1,319 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// PenaltyCategory is a category of enforcement proceedings
+type PenaltyCategory string
+
+const (
+	PenaltyMoneyRecovery          PenaltyCategory = "01"   // стягнення коштів
+	PenaltyPropertyForeclosure    PenaltyCategory = "02"   // звернення стягнення на майно
+	PenaltyAliment                PenaltyCategory = "03"   // стягнення аліментів
+	PenaltyPeriodicPayments       PenaltyCategory = "04"   // стягнення періодичних платежів (крім аліментів)
+	PenaltyWageArrears            PenaltyCategory = "05"   // стягнення заборгованості із заробітної плати та інших платежів, пов’язаних з трудовими відносинами
+	PenaltySocialPayments         PenaltyCategory = "06"   // стягнення соціальних виплат
+	PenaltyUtilityDebt            PenaltyCategory = "07"   // стягнення заборгованості з оплати комунальних послуг
+	PenaltyAdministrativeFines    PenaltyCategory = "08"   // стягнення штрафів у справах про адміністративні правопорушення
+	PenaltyTrafficFines           PenaltyCategory = "09"   // стягнення штрафів у справах про адміністративні правопорушення у сфері безпеки дорожнього руху
+	PenaltyClaimSecurity          PenaltyCategory = "10"   // забезпечення позову
+	PenaltyObligationToAct        PenaltyCategory = "11"   // зобов’язання вчинити певні дії або утриматися від їх вчинення
+	PenaltyReinstatement          PenaltyCategory = "12"   // поновлення на роботі
+	PenaltyMovingIn               PenaltyCategory = "13"   // вселення стягувача
+	PenaltyEviction               PenaltyCategory = "14"   // виселення
+	PenaltyChildRemoval           PenaltyCategory = "15"   // відібрання дитини
+	PenaltyProhibition            PenaltyCategory = "16"   // заборона вчиняти певні дії
+	PenaltyConfiscation           PenaltyCategory = "17"   // конфіскація майна
+	PenaltyCustomsConfiscation    PenaltyCategory = "18"   // конфіскація майна, вилученого митними органами
+	PenaltyConvictConfiscation    PenaltyCategory = "18.1" // конфіскація майна засуджених
+	PenaltyCorruptionConfiscation PenaltyCategory = "19"   // конфіскація коштів та майна за вчинення корупційного та пов’язаного з корупцією правопорушення
+	PenaltyPaidSeizure            PenaltyCategory = "20"   // оплатне вилучення
+	PenaltyTransferOfItems        PenaltyCategory = "21"   // передача стягувачу предметів, зазначених у виконавчому документі
+	PenaltyStateRecovery          PenaltyCategory = "22"   // стягнення коштів на користь держави
+	PenaltyEchrDecision           PenaltyCategory = "23"   // рішення Європейського суду з прав людини
+	PenaltyEnforcementFee         PenaltyCategory = "24"   // стягнення виконавчого збору
+	PenaltyEnforcementCosts       PenaltyCategory = "25"   // стягнення витрат виконавчого провадження
+	PenaltyExecutorFines          PenaltyCategory = "26"   // стягнення штрафів, накладених державним, приватним виконавцем
+	PenaltyPrivateExecutorFee     PenaltyCategory = "27"   // стягнення основної винагороди приватного виконавця
+	PenaltyChildVisitation        PenaltyCategory = "28"   // усунення перешкод у побаченні з дитиною, встановлення побачення з дитиною
+)
+
+// PenaltyCategories lists all categories in the order of their codes
+var PenaltyCategories = []PenaltyCategory{
+	PenaltyMoneyRecovery,
+	PenaltyPropertyForeclosure,
+	PenaltyAliment,
+	PenaltyPeriodicPayments,
+	PenaltyWageArrears,
+	PenaltySocialPayments,
+	PenaltyUtilityDebt,
+	PenaltyAdministrativeFines,
+	PenaltyTrafficFines,
+	PenaltyClaimSecurity,
+	PenaltyObligationToAct,
+	PenaltyReinstatement,
+	PenaltyMovingIn,
+	PenaltyEviction,
+	PenaltyChildRemoval,
+	PenaltyProhibition,
+	PenaltyConfiscation,
+	PenaltyCustomsConfiscation,
+	PenaltyConvictConfiscation,
+	PenaltyCorruptionConfiscation,
+	PenaltyPaidSeizure,
+	PenaltyTransferOfItems,
+	PenaltyStateRecovery,
+	PenaltyEchrDecision,
+	PenaltyEnforcementFee,
+	PenaltyEnforcementCosts,
+	PenaltyExecutorFines,
+	PenaltyPrivateExecutorFee,
+	PenaltyChildVisitation,
+}
+
+var penaltyCategoryLabels = map[PenaltyCategory][2]string{
+	PenaltyMoneyRecovery:          {"стягнення коштів", "money recovery"},
+	PenaltyPropertyForeclosure:    {"звернення стягнення на майно", "foreclosure on property"},
+	PenaltyAliment:                {"стягнення аліментів", "alimony recovery"},
+	PenaltyPeriodicPayments:       {"стягнення періодичних платежів (крім аліментів)", "recovery of periodic payments (except alimony)"},
+	PenaltyWageArrears:            {"стягнення заборгованості із заробітної плати та інших платежів, пов’язаних з трудовими відносинами", "recovery of wage arrears and other employment-related payments"},
+	PenaltySocialPayments:         {"стягнення соціальних виплат", "recovery of social payments"},
+	PenaltyUtilityDebt:            {"стягнення заборгованості з оплати комунальних послуг", "recovery of utility debts"},
+	PenaltyAdministrativeFines:    {"стягнення штрафів у справах про адміністративні правопорушення", "recovery of administrative offense fines"},
+	PenaltyTrafficFines:           {"стягнення штрафів у справах про адміністративні правопорушення у сфері безпеки дорожнього руху", "recovery of road traffic offense fines"},
+	PenaltyClaimSecurity:          {"забезпечення позову", "securing a claim"},
+	PenaltyObligationToAct:        {"зобов’язання вчинити певні дії або утриматися від їх вчинення", "obligation to perform or refrain from certain actions"},
+	PenaltyReinstatement:          {"поновлення на роботі", "reinstatement at work"},
+	PenaltyMovingIn:               {"вселення стягувача", "moving in of the creditor"},
+	PenaltyEviction:               {"виселення", "eviction"},
+	PenaltyChildRemoval:           {"відібрання дитини", "removal of a child"},
+	PenaltyProhibition:            {"заборона вчиняти певні дії", "prohibition of certain actions"},
+	PenaltyConfiscation:           {"конфіскація майна", "confiscation of property"},
+	PenaltyCustomsConfiscation:    {"конфіскація майна, вилученого митними органами", "confiscation of property seized by customs"},
+	PenaltyConvictConfiscation:    {"конфіскація майна засуджених", "confiscation of convicts' property"},
+	PenaltyCorruptionConfiscation: {"конфіскація коштів та майна за вчинення корупційного та пов’язаного з корупцією правопорушення", "confiscation of funds and property for corruption offenses"},
+	PenaltyPaidSeizure:            {"оплатне вилучення", "compensated seizure"},
+	PenaltyTransferOfItems:        {"передача стягувачу предметів, зазначених у виконавчому документі", "transfer of items specified in the enforcement document to the creditor"},
+	PenaltyStateRecovery:          {"стягнення коштів на користь держави", "recovery of funds for the state"},
+	PenaltyEchrDecision:           {"рішення Європейського суду з прав людини", "European Court of Human Rights decision"},
+	PenaltyEnforcementFee:         {"стягнення виконавчого збору", "recovery of enforcement fee"},
+	PenaltyEnforcementCosts:       {"стягнення витрат виконавчого провадження", "recovery of enforcement costs"},
+	PenaltyExecutorFines:          {"стягнення штрафів, накладених державним, приватним виконавцем", "recovery of fines imposed by a state or private executor"},
+	PenaltyPrivateExecutorFee:     {"стягнення основної винагороди приватного виконавця", "recovery of private executor's basic fee"},
+	PenaltyChildVisitation:        {"усунення перешкод у побаченні з дитиною, встановлення побачення з дитиною", "removing obstacles to seeing a child, establishing visitation"},
+}
+
+// Label
+// Ukrainian name of the category
+func (c PenaltyCategory) Label() string {
+	return penaltyCategoryLabels[c][0]
+}
+
+// LabelEn
+// English name of the category
+func (c PenaltyCategory) LabelEn() string {
+	return penaltyCategoryLabels[c][1]
+}
+
+// IsValid
+// Reports whether the category is documented by the API
+func (c PenaltyCategory) IsValid() bool {
+	_, ok := penaltyCategoryLabels[c]
+
+	return ok
+}
+
+// PenaltyFilter is a typed set of category and paging parameters
+// for GetPenaltiesByCode and GetPenalties
+type PenaltyFilter struct {
+	Categories []PenaltyCategory // Коди категорій
+	Offset     int               // Зміщення відносно початку результатів пошуку
+	Limit      int               // Кількість записів
+}
+
+// Validate
+// Checks categories and paging
+func (f *PenaltyFilter) Validate() error {
+	for _, category := range f.Categories {
+		if !category.IsValid() {
+			return fmt.Errorf("Unknown penalty category %q", string(category))
+		}
+	}
+
+	if f.Offset < 0 || f.Limit < 0 {
+		return errors.New("Offset and limit must not be negative")
+	}
+
+	return nil
+}
+
+// Params
+// Query parameters with categories encoded as categories[1], categories[2], …
+// as in the GetPenaltiesByCode example. Duplicate categories are skipped.
+func (f *PenaltyFilter) Params() (params map[string]string, err error) {
+	if err = f.Validate(); err != nil {
+		return nil, err
+	}
+
+	params = map[string]string{}
+	seen := map[PenaltyCategory]bool{}
+
+	for _, category := range f.Categories {
+		if seen[category] {
+			continue
+		}
+
+		params[fmt.Sprintf("categories[%d]", len(seen)+1)] = string(category)
+		seen[category] = true
+	}
+
+	if f.Offset != 0 {
+		params["offset"] = strconv.Itoa(f.Offset)
+	}
+
+	if f.Limit != 0 {
+		params["limit"] = strconv.Itoa(f.Limit)
+	}
+
+	return params, nil
+}
+
+// Borrower identifies a debtor by code or by full name and birth date
+type Borrower struct {
+	Code       string    // Код ЄДРПОУ або ІПН боржника
+	FirstName  string    // Ім'я боржника
+	LastName   string    // Прізвище боржника
+	MiddleName string    // По-батькові боржника
+	BirthDate  time.Time // Дата народження боржника
+}
+
+// IsEmpty
+// Reports whether no field is set
+func (b *Borrower) IsEmpty() bool {
+	return b.Code == "" && b.FirstName == "" && b.LastName == "" && b.MiddleName == "" && b.BirthDate.IsZero()
+}
+
+// Creditor identifies a recoverer by code
+type Creditor struct {
+	Code string // Код ЄДРПОУ стягувача
+}
+
+// PenaltySource is a source of enforcement proceedings data
+type PenaltySource string
+
+const (
+	PenaltySourceOpendatabot PenaltySource = "opendatabot" // база даних Opendatabot
+)
+
+// FullPenaltyQuery is a typed set of GetFullPenalty parameters
+type FullPenaltyQuery struct {
+	Borrower Borrower
+	Creditor Creditor
+	Source   PenaltySource // Джерело інформації, за замовчуванням — реєстр
+	Offset   int           // Зміщення відносно початку результатів пошуку
+	Limit    int           // Кількість записів
+}
+
+// Validate
+// Requires a borrower or a creditor and checks paging
+func (q *FullPenaltyQuery) Validate() error {
+	if q.Borrower.IsEmpty() && q.Creditor.Code == "" {
+		return errors.New("Borrower or creditor is not specified")
+	}
+
+	if q.Source != "" && q.Source != PenaltySourceOpendatabot {
+		return fmt.Errorf("Unknown penalty source %s", q.Source)
+	}
+
+	if q.Offset < 0 || q.Limit < 0 {
+		return errors.New("Offset and limit must not be negative")
+	}
+
+	return nil
+}
+
+// Params
+// Query parameters for GetFullPenalty
+func (q *FullPenaltyQuery) Params() (params map[string]string, err error) {
+	if err = q.Validate(); err != nil {
+		return nil, err
+	}
+
+	params = map[string]string{}
+
+	setParam(params, "borrower_code", q.Borrower.Code)
+	setParam(params, "borrower_first_name", q.Borrower.FirstName)
+	setParam(params, "borrower_last_name", q.Borrower.LastName)
+	setParam(params, "borrower_middle_name", q.Borrower.MiddleName)
+	setParam(params, "creditor_code", q.Creditor.Code)
+	setParam(params, "source", string(q.Source))
+
+	if !q.Borrower.BirthDate.IsZero() {
+		params["borrower_birth_date"] = q.Borrower.BirthDate.Format("2006-01-02")
+	}
+
+	if q.Offset != 0 {
+		params["offset"] = strconv.Itoa(q.Offset)
+	}
+
+	if q.Limit != 0 {
+		params["limit"] = strconv.Itoa(q.Limit)
+	}
+
+	return params, nil
+}
+
+// SearchPenaltiesByCode
+// Актуальні виконавчі провадження за кодом боржника з типізованим фільтром
+func (odb *OdbClient) SearchPenaltiesByCode(code string, filter PenaltyFilter) (*PenaltiesSuccess, error) {
+	if err := checkNotEmpty(code); err != nil {
+		return nil, err
+	}
+
+	params, err := filter.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return odb.GetPenaltiesByCode(code, params)
+}
+
+// SearchPenalties
+// Актуальні виконавчі провадження приватної особи за ПІБ та датою народження
+// з типізованим фільтром
+func (odb *OdbClient) SearchPenalties(borrower Borrower, filter PenaltyFilter) (*PenaltyByFioSuccess, error) {
+	if borrower.FirstName == "" || borrower.LastName == "" || borrower.BirthDate.IsZero() {
+		return nil, errors.New("Borrower name and birth date are not specified")
+	}
+
+	params, err := filter.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
+	setParam(params, "middle_name", borrower.MiddleName)
+
+	return odb.GetPenalties(borrower.FirstName, borrower.LastName, borrower.BirthDate.Format("2006-01-02"), params)
+}
+
+// SearchFullPenalty
+// Історія виконавчих проваджень за стороною провадження з типізованим запитом
+func (odb *OdbClient) SearchFullPenalty(query FullPenaltyQuery) (*FullPenaltiesSuccess, error) {
+	params, err := query.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return odb.GetFullPenalty(params)
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPenaltyFilterParams(t *testing.T) {
+	filter := PenaltyFilter{
+		Categories: []PenaltyCategory{PenaltyMoneyRecovery, PenaltyConvictConfiscation, PenaltyMoneyRecovery, PenaltyAliment},
+		Offset:     10,
+		Limit:      5,
+	}
+
+	params, err := filter.Params()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"categories[1]": "01",
+		"categories[2]": "18.1",
+		"categories[3]": "03",
+		"offset":        "10",
+		"limit":         "5",
+	}
+
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("params %v, want %v", params, want)
+	}
+
+	for _, filter := range []PenaltyFilter{{Categories: []PenaltyCategory{"1"}}, {Categories: []PenaltyCategory{"29"}}, {Offset: -1}} {
+		if _, err := filter.Params(); err == nil {
+			t.Errorf("filter %+v accepted", filter)
+		}
+	}
+
+	for _, category := range PenaltyCategories {
+		if !category.IsValid() || category.Label() == "" || category.LabelEn() == "" {
+			t.Errorf("category %q has no labels", category)
+		}
+	}
+}
+
+func TestFullPenaltyQueryParams(t *testing.T) {
+	query := FullPenaltyQuery{
+		Borrower: Borrower{FirstName: "Іван", LastName: "Петров", BirthDate: time.Date(1987, 11, 3, 0, 0, 0, 0, Kyiv)},
+		Source:   PenaltySourceOpendatabot,
+	}
+
+	params, err := query.Params()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"borrower_first_name": "Іван",
+		"borrower_last_name":  "Петров",
+		"borrower_birth_date": "1987-11-03",
+		"source":              "opendatabot",
+	}
+
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("params %v, want %v", params, want)
+	}
+
+	for _, query := range []FullPenaltyQuery{{}, {Creditor: Creditor{Code: "41711425"}, Source: "registry"}} {
+		if _, err := query.Params(); err == nil {
+			t.Errorf("query %+v accepted", query)
+		}
+	}
+}
+
+func TestSearchPenaltiesByCode(t *testing.T) {
+	var query map[string][]string
+
+	client := newTestClient(t, func(req *http.Request) (int, interface{}) {
+		query = req.URL.Query()
+
+		return http.StatusOK, map[string]interface{}{"status": "ok"}
+	})
+
+	filter := PenaltyFilter{Categories: []PenaltyCategory{PenaltyWageArrears, PenaltyWageArrears}}
+
+	if _, err := client.SearchPenaltiesByCode("41711425", filter); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(query["categories[1]"], []string{"05"}) || len(query) != 2 {
+		t.Errorf("query %v", query)
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// PersonName is a Ukrainian personal name (ПІБ).
+// Parts are normalized: title case and the ASCII apostrophe.
+// First and Middle may hold initials such as "І.".
+type PersonName struct {
+	Last   string // Прізвище
+	First  string // Ім'я
+	Middle string // По батькові
+}
+
+// NameStrictness is how strictly PersonName.Matches compares names
+type NameStrictness int
+
+const (
+	NameStrict   NameStrictness = iota // Всі частини збігаються повністю
+	NameInitials                       // Ім'я та по батькові можуть бути ініціалами, відсутнє по батькові не заважає
+	NameLoose                          // Прізвище та ім'я (або ініціал), по батькові не враховується
+)
+
+// apostrophes are the characters used as an apostrophe in names
+var apostrophes = strings.NewReplacer("ʼ", "'", "’", "'", "‘", "'", "`", "'", "′", "'", "´", "'")
+
+// latinLookalikes are Latin letters typed instead of Cyrillic ones
+var latinLookalikes = strings.NewReplacer(
+	"a", "а", "c", "с", "e", "е", "i", "і", "o", "о", "p", "р", "x", "х", "y", "у",
+	"A", "А", "B", "В", "C", "С", "E", "Е", "H", "Н", "I", "І", "K", "К", "M", "М",
+	"O", "О", "P", "Р", "T", "Т", "X", "Х",
+)
+
+// patronymicSuffixes are endings of Ukrainian patronymics, also in transliteration
+var patronymicSuffixes = []string{
+	"ович", "евич", "євич", "івна", "ївна", "овна", "евна", "ична",
+	"ovych", "evych", "ievych", "ovich", "evich", "ivna", "ovna", "ichna", "ychna",
+}
+
+// normalizeNamePart
+// Unifies apostrophes, replaces Latin lookalikes in Cyrillic words
+// and converts to title case, also after a hyphen or a space
+func normalizeNamePart(part string) string {
+	part = strings.TrimSpace(apostrophes.Replace(part))
+
+	if strings.IndexFunc(part, func(r rune) bool { return unicode.Is(unicode.Cyrillic, r) }) >= 0 {
+		part = latinLookalikes.Replace(part)
+	}
+
+	runes := []rune(strings.ToLower(part))
+
+	for i := range runes {
+		if i == 0 || runes[i-1] == '-' || runes[i-1] == ' ' {
+			runes[i] = unicode.ToUpper(runes[i])
+		}
+	}
+
+	return string(runes)
+}
+
+// NewPersonName
+// Name from separate parts, as GetPenalties and the borrower records have it
+func NewPersonName(last, first, middle string) PersonName {
+	name := PersonName{
+		Last:   normalizeNamePart(last),
+		First:  normalizeNamePart(first),
+		Middle: normalizeNamePart(middle),
+	}
+
+	name.First = normalizeInitial(name.First)
+	name.Middle = normalizeInitial(name.Middle)
+
+	return name
+}
+
+func isInitial(part string) bool {
+	return len([]rune(strings.TrimSuffix(part, "."))) == 1
+}
+
+func normalizeInitial(part string) string {
+	if isInitial(part) {
+		return strings.TrimSuffix(part, ".") + "."
+	}
+
+	return part
+}
+
+func isPatronymic(part string) bool {
+	part = strings.ToLower(part)
+
+	for _, suffix := range patronymicSuffixes {
+		if strings.HasSuffix(part, suffix) && len([]rune(part)) > len([]rune(suffix)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParsePersonName
+// Parses ПІБ written as "Прізвище Ім'я По батькові", "Ім'я По батькові Прізвище"
+// or with initials, e.g. "Попов І.В.", "Попов Іван В." and "І. В. Попов".
+// The order is recognized by the patronymic ending, Latin names included.
+func ParsePersonName(value string) (PersonName, error) {
+	tokens := strings.Fields(strings.NewReplacer(".", ". ", ",", " ").Replace(value))
+
+	if len(tokens) == 0 {
+		return PersonName{}, errors.New("Name is not specified")
+	}
+
+	var initials, words []string
+
+	for _, token := range tokens {
+		if isInitial(token) {
+			initials = append(initials, token)
+		} else {
+			words = append(words, token)
+		}
+	}
+
+	if len(initials) > 0 && len(words) > 0 {
+		return parseNameWithInitials(tokens), nil
+	}
+
+	patronymic := -1
+
+	for i, token := range tokens {
+		if i > 0 && isPatronymic(token) {
+			patronymic = i
+			break
+		}
+	}
+
+	switch {
+	case len(tokens) == 1:
+		return NewPersonName(tokens[0], "", ""), nil
+	case patronymic == 1:
+		// Ім'я По батькові [Прізвище]
+		return NewPersonName(strings.Join(tokens[2:], " "), tokens[0], tokens[1]), nil
+	case patronymic > 1:
+		// Прізвище Ім'я По батькові, the last name may have several words
+		return NewPersonName(
+			strings.Join(tokens[:patronymic-1], " "),
+			tokens[patronymic-1],
+			strings.Join(tokens[patronymic:], " "),
+		), nil
+	}
+
+	return NewPersonName(tokens[0], tokens[1], strings.Join(tokens[2:], " ")), nil
+}
+
+// parseNameWithInitials
+// Name of words and initials: "Прізвище І.В.", "Прізвище Ім'я В.", "І. В. Прізвище"
+// or "Ім'я В. Прізвище". The first name and the middle name are taken in order.
+func parseNameWithInitials(tokens []string) PersonName {
+	first := -1
+	lastWord := -1
+
+	for i, token := range tokens {
+		if isInitial(token) {
+			if first < 0 {
+				first = i
+			}
+		} else {
+			lastWord = i
+		}
+	}
+
+	last := 0
+
+	switch {
+	case first == 0:
+		// І. В. Прізвище
+		last = lastWord
+	case lastWord > first && !isPatronymic(tokens[lastWord]):
+		// Ім'я В. Прізвище
+		last = lastWord
+	}
+
+	rest := make([]string, 0, len(tokens)+1)
+
+	for i, token := range tokens {
+		if i != last {
+			rest = append(rest, token)
+		}
+	}
+
+	rest = append(rest, "")
+
+	return NewPersonName(tokens[last], rest[0], strings.Join(rest[1:], " "))
+}
+
+// String
+// Full form "Прізвище Ім'я По батькові"
+func (n PersonName) String() string {
+	return strings.Join(strings.Fields(strings.Join([]string{n.Last, n.First, n.Middle}, " ")), " ")
+}
+
+// Key
+// Lower case full form to use as a map key
+func (n PersonName) Key() string {
+	return strings.ToLower(n.String())
+}
+
+// Initials
+// Initials of the first and middle names, e.g. "І.В."
+func (n PersonName) Initials() string {
+	var initials strings.Builder
+
+	for _, part := range []string{n.First, n.Middle} {
+		if runes := []rune(part); len(runes) > 0 {
+			initials.WriteRune(runes[0])
+			initials.WriteString(".")
+		}
+	}
+
+	return initials.String()
+}
+
+// Short
+// Short form "Прізвище І.Б." used by court records
+func (n PersonName) Short() string {
+	return strings.TrimSpace(n.Last + " " + n.Initials())
+}
+
+// IsEmpty
+// Reports whether no part is set
+func (n PersonName) IsEmpty() bool {
+	return n.Last == "" && n.First == "" && n.Middle == ""
+}
+
+// HasInitials
+// Reports whether the first or the middle name is given only as an initial
+func (n PersonName) HasInitials() bool {
+	return isInitial(n.First) || isInitial(n.Middle)
+}
+
+// namePartMatch
+// 1 for equal parts, 0.5 when one of them is the initial of the other, 0 otherwise
+func namePartMatch(a, b string) float64 {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+
+	if a == b {
+		return 1
+	}
+
+	if !isInitial(a) && !isInitial(b) {
+		return 0
+	}
+
+	ra, rb := []rune(a), []rune(b)
+
+	if len(ra) > 0 && len(rb) > 0 && ra[0] == rb[0] {
+		return 0.5
+	}
+
+	return 0
+}
+
+// Matches
+// Compares names with the given strictness; the last names must always be equal
+func (n PersonName) Matches(other PersonName, strictness NameStrictness) bool {
+	if namePartMatch(n.Last, other.Last) != 1 {
+		return false
+	}
+
+	switch strictness {
+	case NameStrict:
+		return namePartMatch(n.First, other.First) == 1 && namePartMatch(n.Middle, other.Middle) == 1
+	case NameInitials:
+		if n.Middle != "" && other.Middle != "" && namePartMatch(n.Middle, other.Middle) == 0 {
+			return false
+		}
+
+		return namePartMatch(n.First, other.First) > 0
+	}
+
+	return n.First == "" || other.First == "" || namePartMatch(n.First, other.First) > 0
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import "testing"
+
+func TestParsePersonName(t *testing.T) {
+	tests := []struct {
+		value string
+		want  PersonName
+	}{
+		{"Попов Іван Васильович", PersonName{"Попов", "Іван", "Васильович"}},
+		{"Іван Васильович Попов", PersonName{"Попов", "Іван", "Васильович"}},
+		{"ПОПОВ ІВАН", PersonName{"Попов", "Іван", ""}},
+		{"Попов", PersonName{"Попов", "", ""}},
+		{"Попов І.В.", PersonName{"Попов", "І.", "В."}},
+		{"Попов І. В.", PersonName{"Попов", "І.", "В."}},
+		{"І. В. Попов", PersonName{"Попов", "І.", "В."}},
+		{"І.В.Попов", PersonName{"Попов", "І.", "В."}},
+		{"Попов Іван В.", PersonName{"Попов", "Іван", "В."}},
+		{"Попов І. Васильович", PersonName{"Попов", "І.", "Васильович"}},
+		{"Кос-Анатольський Ім'я В.", PersonName{"Кос-Анатольський", "Ім'я", "В."}},
+		{"Шевчук Оксана Петрівна", PersonName{"Шевчук", "Оксана", "Петрівна"}},
+		{"Popov Ivan Vasylovych", PersonName{"Popov", "Ivan", "Vasylovych"}},
+		{"Ivan Vasylovych Popov", PersonName{"Popov", "Ivan", "Vasylovych"}},
+		{"Oksana Petrivna Shevchuk", PersonName{"Shevchuk", "Oksana", "Petrivna"}},
+		{"Ivan V. Popov", PersonName{"Popov", "Ivan", "V."}},
+		{"POPOV I.V.", PersonName{"Popov", "I.", "V."}},
+	}
+
+	for _, test := range tests {
+		got, err := ParsePersonName(test.value)
+
+		if err != nil || got != test.want {
+			t.Errorf("ParsePersonName(%q) = %+v, %v, want %+v", test.value, got, err, test.want)
+		}
+	}
+
+	if _, err := ParsePersonName("  "); err == nil {
+		t.Error("empty name parsed")
+	}
+}
+
+func TestPersonNameMatches(t *testing.T) {
+	tests := []struct {
+		a, b       string
+		strictness NameStrictness
+		want       bool
+	}{
+		{"Попов Іван Васильович", "ПОПОВ ІВАН ВАСИЛЬОВИЧ", NameStrict, true},
+		{"Попов Іван Васильович", "Попов І.В.", NameStrict, false},
+		{"Попов Іван Васильович", "Попов І.В.", NameInitials, true},
+		{"Попов Іван Васильович", "Попов Іван В.", NameInitials, true},
+		{"Попов Іван Васильович", "І. В. Попов", NameInitials, true},
+		{"Попов Іван Васильович", "Попов І.П.", NameInitials, false},
+		{"Попов Іван Васильович", "Попов Іван", NameInitials, true},
+		{"Попов Іван Васильович", "Попов Олег Васильович", NameInitials, false},
+		{"Попов Іван Васильович", "Попов Іван Петрович", NameLoose, true},
+		{"Попов Іван Васильович", "Попова Іванна Василівна", NameLoose, false},
+	}
+
+	for _, test := range tests {
+		a, _ := ParsePersonName(test.a)
+		b, _ := ParsePersonName(test.b)
+
+		if got := a.Matches(b, test.strictness); got != test.want {
+			t.Errorf("%q matches %q (%d) = %v, want %v", test.a, test.b, test.strictness, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// RealtyPayload is an extract field as received: a JSON string holding JSON,
+// or the JSON itself, e.g. [] or [{...}]
+type RealtyPayload json.RawMessage
+
+func (p *RealtyPayload) UnmarshalJSON(data []byte) error {
+	*p = append((*p)[:0], data...)
+
+	return nil
+}
+
+func (p RealtyPayload) MarshalJSON() ([]byte, error) {
+	if len(bytes.TrimSpace(p)) == 0 {
+		return []byte("null"), nil
+	}
+
+	return p, nil
+}
+
+// String
+// JSON text of the field, unquoted when it was sent as a string
+func (p RealtyPayload) String() string {
+	data := bytes.TrimSpace(p)
+
+	if len(data) > 0 && data[0] == '"' {
+		var value string
+
+		if err := json.Unmarshal(data, &value); err == nil {
+			return value
+		}
+	}
+
+	if string(data) == "null" {
+		return ""
+	}
+
+	return string(data)
+}
+
+// RealtySubject is a participant of a right, a mortgage or an encumbrance
+type RealtySubject struct {
+	Name    string     `json:"sbjName"`     // Назва або ПІБ
+	Code    FlexString `json:"sbjCode"`     // Код ЄДРПОУ або РНОКПП
+	Role    string     `json:"sbjRlName"`   // Роль суб'єкта
+	Type    FlexString `json:"dcSbjType"`   // Тип суб'єкта: 1 фізична, 2 юридична особа
+	Country string     `json:"countryName"` // Країна
+}
+
+// RealtyDocument is a document the registration is based on
+type RealtyDocument struct {
+	Type      string     `json:"cdType"`    // Тип документа
+	Number    FlexString `json:"enum"`      // Номер
+	Date      Date       `json:"docDate"`   // Дата
+	Publisher string     `json:"publisher"` // Видавник
+}
+
+// RealtyRight is a registered property right
+type RealtyRight struct {
+	Number         FlexString       `json:"rnNum"`          // Номер запису про право
+	RegDate        Date             `json:"regDate"`        // Дата реєстрації
+	Kind           string           `json:"prKind"`         // Форма власності або вид права
+	Type           string           `json:"prType"`         // Тип права
+	State          string           `json:"prState"`        // Стан
+	PartSize       FlexString       `json:"partSize"`       // Розмір частки
+	Subjects       []RealtySubject  `json:"subjects"`       // Власники та правонабувачі
+	CauseDocuments []RealtyDocument `json:"causeDocuments"` // Підстави
+}
+
+// RealtyMortgage is a registered mortgage
+type RealtyMortgage struct {
+	Number         FlexString       `json:"rnNum"`          // Номер запису про іпотеку
+	RegDate        Date             `json:"regDate"`        // Дата реєстрації
+	State          string           `json:"mgState"`        // Стан
+	ObligationSum  FlexString       `json:"obligationSum"`  // Розмір основного зобов'язання
+	TermDate       Date             `json:"termDate"`       // Строк виконання
+	Subjects       []RealtySubject  `json:"subjects"`       // Іпотекодержателі, іпотекодавці, боржники
+	CauseDocuments []RealtyDocument `json:"causeDocuments"` // Підстави
+}
+
+// RealtyLimitation is a registered encumbrance
+type RealtyLimitation struct {
+	Number         FlexString       `json:"rnNum"`          // Номер запису про обтяження
+	RegDate        Date             `json:"regDate"`        // Дата реєстрації
+	Type           string           `json:"lmType"`         // Вид обтяження
+	State          string           `json:"lmState"`        // Стан
+	Description    string           `json:"lmDescription"`  // Зміст обтяження
+	Subjects       []RealtySubject  `json:"subjects"`       // Обтяжувачі та особи, майно яких обтяжується
+	CauseDocuments []RealtyDocument `json:"causeDocuments"` // Підстави
+}
+
+// RealtyObject is a property object of the current register
+type RealtyObject struct {
+	RegNum      FlexString         `json:"regNum"`      // Реєстраційний номер об'єкта
+	RegDate     Date               `json:"regDate"`     // Дата реєстрації
+	Type        string             `json:"reType"`      // Тип об'єкта
+	State       string             `json:"reState"`     // Стан
+	CadNum      string             `json:"cadNum"`      // Кадастровий номер
+	Area        FlexString         `json:"area"`        // Загальна площа
+	LivingArea  FlexString         `json:"livingArea"`  // Житлова площа
+	Address     string             `json:"address"`     // Адреса
+	Description string             `json:"description"` // Опис об'єкта
+	Properties  []RealtyRight      `json:"properties"`  // Права власності
+	Mortgages   []RealtyMortgage   `json:"mortgage"`    // Іпотеки
+	Limitations []RealtyLimitation `json:"limitation"`  // Обтяження
+
+	Raw json.RawMessage `json:"-"` // Запис у вигляді реєстру
+}
+
+// OldRealtyRecord is a record of the property register kept before 2013
+type OldRealtyRecord struct {
+	RegNum    FlexString `json:"regNum"`    // Реєстраційний номер
+	RegDate   Date       `json:"regDate"`   // Дата реєстрації
+	Type      string     `json:"reType"`    // Тип об'єкта
+	Address   string     `json:"address"`   // Адреса
+	Area      FlexString `json:"area"`      // Загальна площа
+	Owners    string     `json:"owners"`    // Власники
+	PartSize  FlexString `json:"partSize"`  // Розмір частки
+	Document  string     `json:"document"`  // Правовстановлюючий документ
+	Registrar string     `json:"registrar"` // Реєстратор
+
+	Raw json.RawMessage `json:"-"` // Запис у вигляді реєстру
+}
+
+// OldMortgageRecord is a record of the mortgage register kept before 2013
+type OldMortgageRecord struct {
+	RegNum        FlexString `json:"regNum"`        // Реєстраційний номер
+	RegDate       Date       `json:"regDate"`       // Дата реєстрації
+	Mortgagee     string     `json:"mortgagee"`     // Іпотекодержатель
+	Mortgagor     string     `json:"mortgagor"`     // Іпотекодавець
+	Debtor        string     `json:"debtor"`        // Боржник
+	ObligationSum FlexString `json:"obligationSum"` // Розмір основного зобов'язання
+	TermDate      Date       `json:"termDate"`      // Строк виконання
+	Object        string     `json:"object"`        // Предмет іпотеки
+	State         string     `json:"state"`         // Стан
+
+	Raw json.RawMessage `json:"-"` // Запис у вигляді реєстру
+}
+
+// OldLimitationRecord is a record of the encumbrance register kept before 2013
+type OldLimitationRecord struct {
+	RegNum       FlexString `json:"regNum"`       // Реєстраційний номер
+	RegDate      Date       `json:"regDate"`      // Дата реєстрації
+	Type         string     `json:"lmType"`       // Вид обтяження
+	Encumbrancer string     `json:"encumbrancer"` // Обтяжувач
+	Owner        string     `json:"owner"`        // Особа, майно якої обтяжується
+	Object       string     `json:"object"`       // Об'єкт обтяження
+	Description  string     `json:"description"`  // Зміст обтяження
+	State        string     `json:"state"`        // Стан
+
+	Raw json.RawMessage `json:"-"` // Запис у вигляді реєстру
+}
+
+// RealtyExtract is the extract data decoded from RealtyPayloads.
+// Records that cannot be fully decoded keep the fields read so far and Raw, errors are kept in Errors.
+type RealtyExtract struct {
+	Realty         []RealtyObject        `json:"realty"`          // Актуальна інформація про нерухомість
+	OldRealty      []OldRealtyRecord     `json:"old_realty"`      // Нерухомість до 2013р
+	OldMortgages   []OldMortgageRecord   `json:"old_mortgages"`   // Іпотеки до 2013р
+	OldLimitations []OldLimitationRecord `json:"old_limitations"` // Обтяження до 2013р
+	Addresses      []string              `json:"addresses"`       // Інші адреси
+	Raw            RealtyPayloads        `json:"raw"`             // Вихідні дані
+	Errors         map[string]error      `json:"-"`               // Помилки за назвою поля, наприклад realty[2]
+}
+
+// IsComplete
+// Reports whether every payload was decoded without errors
+func (e *RealtyExtract) IsComplete() bool {
+	return len(e.Errors) == 0
+}
+
+// Decode
+// Unpacks the extract data into typed records
+func (r *RealtyResultSuccess) Decode() *RealtyExtract {
+	return r.Data.Data.Decode()
+}
+
+// Decode
+// Unpacks the payloads into typed records. Empty payloads give no records,
+// a payload may hold a list or a single record, sent as JSON or as a JSON string.
+// One broken record does not stop the others.
+func (p RealtyPayloads) Decode() *RealtyExtract {
+	extract := &RealtyExtract{Raw: p, Errors: map[string]error{}}
+
+	decodeRealtyPayload(p.Realty, "realty", extract.Errors, func(raw json.RawMessage) error {
+		item := RealtyObject{Raw: raw}
+		err := json.Unmarshal(raw, &item)
+
+		extract.Realty = append(extract.Realty, item)
+
+		return err
+	})
+
+	decodeRealtyPayload(p.OldRealty, "oldRealty", extract.Errors, func(raw json.RawMessage) error {
+		item := OldRealtyRecord{Raw: raw}
+		err := json.Unmarshal(raw, &item)
+
+		extract.OldRealty = append(extract.OldRealty, item)
+
+		return err
+	})
+
+	decodeRealtyPayload(p.OldMortgageJson, "oldMortgageJson", extract.Errors, func(raw json.RawMessage) error {
+		item := OldMortgageRecord{Raw: raw}
+		err := json.Unmarshal(raw, &item)
+
+		extract.OldMortgages = append(extract.OldMortgages, item)
+
+		return err
+	})
+
+	decodeRealtyPayload(p.OldLimitationJson, "oldLimitationJson", extract.Errors, func(raw json.RawMessage) error {
+		item := OldLimitationRecord{Raw: raw}
+		err := json.Unmarshal(raw, &item)
+
+		extract.OldLimitations = append(extract.OldLimitations, item)
+
+		return err
+	})
+
+	decodeRealtyPayload(p.AllAdresses, "allAdresses", extract.Errors, func(raw json.RawMessage) error {
+		address, err := decodeRealtyAddress(raw)
+
+		if address != "" {
+			extract.Addresses = append(extract.Addresses, address)
+		}
+
+		return err
+	})
+
+	return extract
+}
+
+// decodeRealtyPayload
+// Calls item for every record of a payload and stores errors by field name
+func decodeRealtyPayload(payload RealtyPayload, field string, errs map[string]error, item func(json.RawMessage) error) {
+	records, err := realtyRecords(payload)
+
+	if err != nil {
+		errs[field] = err
+		return
+	}
+
+	for i, record := range records {
+		if err = item(record); err != nil {
+			errs[fmt.Sprintf("%s[%d]", field, i)] = err
+		}
+	}
+}
+
+// realtyRecords
+// Records of a payload: nothing for an empty value, null, [] or {},
+// the elements of a list, or the value itself for a single record.
+// A JSON string is unpacked and read the same way.
+func realtyRecords(data []byte) ([]json.RawMessage, error) {
+	data = bytes.TrimSpace(data)
+
+	switch {
+	case len(data) == 0, string(data) == "null", string(data) == "{}", string(data) == `""`:
+		return nil, nil
+	case data[0] == '"':
+		var inner string
+
+		if err := json.Unmarshal(data, &inner); err != nil {
+			return nil, err
+		}
+
+		return realtyRecords([]byte(inner))
+	case data[0] == '[':
+		var records []json.RawMessage
+
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, err
+		}
+
+		return records, nil
+	case data[0] == '{':
+		if !json.Valid(data) {
+			return nil, fmt.Errorf("Invalid realty payload %.40q", data)
+		}
+
+		return []json.RawMessage{json.RawMessage(data)}, nil
+	}
+
+	// A plain text value, e.g. a single address
+	encoded, err := json.Marshal(string(data))
+
+	return []json.RawMessage{encoded}, err
+}
+
+// decodeRealtyAddress
+// Address from a string or from an object with an address or name field
+func decodeRealtyAddress(raw json.RawMessage) (string, error) {
+	raw = bytes.TrimSpace(raw)
+
+	if len(raw) > 0 && raw[0] == '{' {
+		var item struct {
+			Address string `json:"address"`
+			Name    string `json:"name"`
+		}
+
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return "", err
+		}
+
+		if item.Address != "" {
+			return item.Address, nil
+		}
+
+		return item.Name, nil
+	}
+
+	var address FlexString
+
+	err := json.Unmarshal(raw, &address)
+
+	return address.String(), err
+}
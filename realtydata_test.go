@@ -0,0 +1,98 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+const realtyRecordJson = `[{"regNum":123,"regDate":"01.02.2015","area":"45.5","address":"м. Київ, вул. Хрещатик, 1",` +
+	`"properties":[{"rnNum":"77","partSize":"1/2","subjects":[{"sbjName":"Іванов Іван Іванович","sbjCode":1234567890}]}]}]`
+
+func TestGetRealtyResultDecodesPayloads(t *testing.T) {
+	quoted, _ := json.Marshal(realtyRecordJson)
+
+	tests := []struct {
+		name     string
+		data     string
+		realty   int
+		old      int
+		complete bool
+	}{
+		{"string", `{"realty":` + string(quoted) + `,"oldRealty":"[{\"regNum\":\"5\",\"owners\":\"Петров\"}]"}`, 1, 1, true},
+		{"raw", `{"realty":` + realtyRecordJson + `,"oldRealty":{"regNum":"5","owners":"Петров"}}`, 1, 1, true},
+		{"empty", `{"realty":"","oldRealty":[],"oldMortgageJson":null,"oldLimitationJson":{},"allAdresses":"[]"}`, 0, 0, true},
+		{"missing", `{}`, 0, 0, true},
+		{"broken string", `{"realty":"[{\"regNum\":","oldRealty":[{"regNum":"5"}]}`, 0, 1, false},
+		{"broken record", `{"realty":[{"regNum":{"id":1},"address":"м. Київ"}]}`, 1, 0, false},
+	}
+
+	for _, test := range tests {
+		client := newTestClient(t, func(req *http.Request) (int, interface{}) {
+			return http.StatusOK, json.RawMessage(`{"status":"ok","data":{"status":"done","pdf_link":"x","data":` + test.data + `}}`)
+		})
+
+		result, err := client.GetRealtyResult("057557bde3148f33a3d787c615e9404b")
+
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+
+		extract := result.Decode()
+
+		if len(extract.Realty) != test.realty || len(extract.OldRealty) != test.old || extract.IsComplete() != test.complete {
+			t.Errorf("%s: %d realty, %d old realty, errors %v", test.name, len(extract.Realty), len(extract.OldRealty), extract.Errors)
+		}
+
+		for _, object := range extract.Realty {
+			if len(object.Raw) == 0 || object.Address != "м. Київ, вул. Хрещатик, 1" && object.Address != "м. Київ" {
+				t.Errorf("%s: record %+v", test.name, object)
+			}
+		}
+	}
+}
+
+func TestRealtyPayloadsDecodeRecord(t *testing.T) {
+	var payloads RealtyPayloads
+
+	data := `{"realty":` + realtyRecordJson + `,"allAdresses":["м. Одеса",{"address":"м. Львів"},{"name":"м. Дніпро"}]}`
+
+	if err := json.Unmarshal([]byte(data), &payloads); err != nil {
+		t.Fatal(err)
+	}
+
+	extract := payloads.Decode()
+
+	if !extract.IsComplete() || len(extract.Realty) != 1 {
+		t.Fatalf("errors %v", extract.Errors)
+	}
+
+	object := extract.Realty[0]
+
+	if object.RegNum != "123" || object.Area != "45.5" || object.RegDate.Format(dateLayout) != "2015-02-01" {
+		t.Errorf("object %+v", object)
+	}
+
+	if subject := object.Properties[0].Subjects[0]; subject.Code != "1234567890" || subject.Name != "Іванов Іван Іванович" {
+		t.Errorf("subject %+v", subject)
+	}
+
+	if len(extract.Addresses) != 3 || extract.Addresses[2] != "м. Дніпро" {
+		t.Errorf("addresses %v", extract.Addresses)
+	}
+
+	if payloads.Realty.String() != realtyRecordJson {
+		t.Errorf("raw realty %s", payloads.Realty)
+	}
+
+	encoded, err := json.Marshal(payloads)
+
+	if err != nil || string(encoded) != `{"realty":`+realtyRecordJson+`,"oldMortgageJson":null,"oldLimitationJson":null,"oldRealty":null,"allAdresses":["м. Одеса",{"address":"м. Львів"},{"name":"м. Дніпро"}]}` {
+		t.Errorf("encoded %s, %v", encoded, err)
+	}
+}
@@ -0,0 +1,318 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// realtyPending is the status of an extract that is still being prepared
+const realtyPending = "pending"
+
+// ErrRealtyExtractPending is returned by RunRealtyExtract when the extract
+// is not ready within MaxWait; the job can be run again later
+var ErrRealtyExtractPending = errors.New("Realty extract is still pending")
+
+// RealtyJobStage is a step of ordering an extract
+type RealtyJobStage string
+
+const (
+	RealtyJobNew     RealtyJobStage = "new"     // Витяг ще не замовлено
+	RealtyJobOrdered RealtyJobStage = "ordered" // Витяг замовлено, результат формується
+	RealtyJobReady   RealtyJobStage = "ready"   // Витяг сформовано, PdfLink доступне
+	RealtyJobFailed  RealtyJobStage = "failed"  // Формування завершилось без PDF
+)
+
+// RealtyExtractJob is the state of ordering one property extract.
+// It is serializable to JSON, so a job interrupted by a restart or
+// ErrRealtyExtractPending can be stored and passed to RunRealtyExtract again.
+type RealtyExtractJob struct {
+	ReportResultId string         `json:"report_result_id,omitempty"` // Ідентифікатор групи адрес суб'єкта з GetRealty
+	ObjectId       string         `json:"object_id,omitempty"`        // Ідентифікатор об'єкта групи
+	Name           string         `json:"name,omitempty"`             // Адреса нерухомості
+	Number         string         `json:"number,omitempty"`           // Кадастровий номер або номер реєстрації, замість ReportResultId та ObjectId
+	ResultId       string         `json:"result_id,omitempty"`        // Ідентифікатор витягу для GetRealtyResult
+	Stage          RealtyJobStage `json:"stage"`                      // Етап
+	Status         string         `json:"status,omitempty"`           // Останній статус обробки запиту
+	PdfLink        string         `json:"pdf_link,omitempty"`         // Посилання на PDF документ
+	OrderedAt      time.Time      `json:"ordered_at"`                 // Час замовлення
+	CheckedAt      time.Time      `json:"checked_at"`                 // Час останньої перевірки
+	Checks         int            `json:"checks"`                     // Кількість перевірок
+
+	Result *RealtyResultSuccess `json:"-"` // Остання відповідь GetRealtyResult
+}
+
+// NewRealtyExtractJob
+// Job for an object found by GetRealty
+func NewRealtyExtractJob(reportResultId, objectId string) *RealtyExtractJob {
+	return &RealtyExtractJob{ReportResultId: reportResultId, ObjectId: objectId, Stage: RealtyJobNew}
+}
+
+// NewRealtyExtractJobByNumber
+// Job for a cadastral or registration number
+func NewRealtyExtractJobByNumber(number string) *RealtyExtractJob {
+	return &RealtyExtractJob{Number: number, Stage: RealtyJobNew}
+}
+
+// IsDone
+// Reports whether the job does not need to be run again
+func (j *RealtyExtractJob) IsDone() bool {
+	return j.Stage == RealtyJobReady || j.Stage == RealtyJobFailed
+}
+
+// RealtyPollOptions configures polling of GetRealtyResult
+type RealtyPollOptions struct {
+	Interval    time.Duration // Перша пауза між перевірками
+	MaxInterval time.Duration // Найбільша пауза, пауза подвоюється до неї
+	MaxWait     time.Duration // Найбільший час очікування за один запуск
+}
+
+// DefaultRealtyPollOptions
+// Checks after 5 seconds, backing off up to a minute, for at most 10 minutes
+func DefaultRealtyPollOptions() RealtyPollOptions {
+	return RealtyPollOptions{
+		Interval:    5 * time.Second,
+		MaxInterval: time.Minute,
+		MaxWait:     10 * time.Minute,
+	}
+}
+
+// RealtyExtractJobs
+// Finds the objects of a company or a person with GetRealty and returns a job for each of them
+func (odb *OdbClient) RealtyExtractJobs(
+	ctx context.Context,
+	code string, // код ЄДРПОУ або ІПН
+	params map[string]string, // параметри GetRealty
+) (jobs []*RealtyExtractJob, err error) {
+	realty, err := odb.WithContext(ctx).GetRealty(code, params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range realty.Data.Items {
+		job := NewRealtyExtractJob(realty.Data.ReportResultId, item.Id)
+		job.Name = item.Name
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+// RunRealtyExtract
+// Orders the extract unless it is already ordered, then polls GetRealtyResult
+// until the status leaves "pending". Pauses start at Interval and double up to MaxInterval;
+// rate limited orders and checks are retried the same way. When MaxWait passes, ErrRealtyExtractPending
+// is returned and the job keeps its state to be run again. A ready job is returned as is,
+// a failed one gives its failure again.
+func (odb *OdbClient) RunRealtyExtract(ctx context.Context, job *RealtyExtractJob, options RealtyPollOptions) error {
+	client := odb.WithContext(ctx)
+
+	switch job.Stage {
+	case RealtyJobReady:
+		return nil
+	case RealtyJobFailed:
+		return job.failure()
+	}
+
+	backoff := newRealtyBackoff(options)
+
+	for job.ResultId == "" {
+		err := client.orderRealtyExtract(job)
+
+		var apiErr *ApiError
+
+		switch {
+		case errors.As(err, &apiErr) && apiErr.IsRateLimited():
+		case err != nil:
+			return err
+		default:
+			continue
+		}
+
+		if err = backoff.wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	for {
+		result, err := client.GetRealtyResult(job.ResultId)
+
+		var apiErr *ApiError
+
+		switch {
+		case errors.As(err, &apiErr) && apiErr.IsRateLimited():
+		case err != nil:
+			return err
+		default:
+			job.Checks++
+			job.CheckedAt = time.Now()
+			job.Result = result
+			job.Status = result.Data.Status
+			job.PdfLink = result.Data.PdfLink
+
+			if job.Status != realtyPending {
+				return job.finish()
+			}
+		}
+
+		if err = backoff.wait(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// realtyBackoff paces the requests of one RunRealtyExtract call
+type realtyBackoff struct {
+	interval    time.Duration
+	maxInterval time.Duration
+	deadline    time.Time
+}
+
+func newRealtyBackoff(options RealtyPollOptions) *realtyBackoff {
+	backoff := &realtyBackoff{interval: options.Interval, maxInterval: options.MaxInterval}
+
+	if backoff.interval <= 0 {
+		backoff.interval = DefaultRealtyPollOptions().Interval
+	}
+
+	if options.MaxWait > 0 {
+		backoff.deadline = time.Now().Add(options.MaxWait)
+	}
+
+	return backoff
+}
+
+// wait
+// Sleeps before the next request and doubles the pause,
+// ErrRealtyExtractPending once the deadline has passed
+func (b *realtyBackoff) wait(ctx context.Context) error {
+	wait := b.interval
+
+	if !b.deadline.IsZero() {
+		left := time.Until(b.deadline)
+
+		if left <= 0 {
+			return ErrRealtyExtractPending
+		}
+
+		if wait > left {
+			wait = left
+		}
+	}
+
+	if err := sleepContext(ctx, wait); err != nil {
+		return err
+	}
+
+	if b.interval *= 2; b.maxInterval > 0 && b.interval > b.maxInterval {
+		b.interval = b.maxInterval
+	}
+
+	return nil
+}
+
+// orderRealtyExtract
+// Orders the extract by object or by number and moves the job to RealtyJobOrdered
+func (odb *OdbClient) orderRealtyExtract(job *RealtyExtractJob) error {
+	switch {
+	case job.Number != "":
+		response, err := odb.GetRealtyReportByNumber(job.Number)
+
+		if err != nil {
+			return err
+		}
+
+		job.ResultId = response.Data.ResultId
+	case job.ReportResultId != "" && job.ObjectId != "":
+		response, err := odb.GetRealtyById(job.ReportResultId, job.ObjectId)
+
+		if err != nil {
+			return err
+		}
+
+		job.ResultId = response.Data.ResultId
+	default:
+		return errors.New("Realty object or number is not specified")
+	}
+
+	if job.ResultId == "" {
+		return errors.New("Realty extract was not ordered: empty result id")
+	}
+
+	job.Stage = RealtyJobOrdered
+	job.OrderedAt = time.Now()
+
+	return nil
+}
+
+// finish
+// Moves a job that left "pending" to ready or failed
+func (j *RealtyExtractJob) finish() error {
+	if j.PdfLink == "" {
+		j.Stage = RealtyJobFailed
+
+		return j.failure()
+	}
+
+	j.Stage = RealtyJobReady
+
+	return nil
+}
+
+// failure
+// Error of a failed job, built from its stored state
+func (j *RealtyExtractJob) failure() error {
+	return fmt.Errorf("Realty extract %s finished with status %q and no PDF", j.ResultId, j.Status)
+}
+
+// DownloadRealtyPdf
+// Streams the PDF of a ready extract to w and returns the number of bytes written
+func (odb *OdbClient) DownloadRealtyPdf(ctx context.Context, job *RealtyExtractJob, w io.Writer) (int64, error) {
+	if job.Stage != RealtyJobReady || job.PdfLink == "" {
+		return 0, errors.New("Realty extract is not ready")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, job.PdfLink, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	client := odb.Settings.Client
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, &ApiError{StatusCode: resp.StatusCode}
+	}
+
+	return io.Copy(w, resp.Body)
+}
+
+// FetchRealtyExtract
+// Runs the job with RunRealtyExtract and streams the ready PDF to w
+func (odb *OdbClient) FetchRealtyExtract(ctx context.Context, job *RealtyExtractJob, options RealtyPollOptions, w io.Writer) (int64, error) {
+	if err := odb.RunRealtyExtract(ctx, job, options); err != nil {
+		return 0, err
+	}
+
+	return odb.DownloadRealtyPdf(ctx, job, w)
+}
@@ -0,0 +1,175 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// realtyServer answers realty extract requests with the queued statuses,
+// 0 meaning a rate limited response
+type realtyServer struct {
+	orders  []int
+	results []string
+	pdfLink string
+
+	orderRequests  int
+	resultRequests int
+}
+
+func (s *realtyServer) handle(req *http.Request) (int, interface{}) {
+	switch req.URL.Path {
+	case "/api/v2/realty-report/1234567890:12:345:6789":
+		s.orderRequests++
+
+		if len(s.orders) > 0 {
+			status := s.orders[0]
+			s.orders = s.orders[1:]
+
+			if status == 0 {
+				return http.StatusTooManyRequests, nil
+			}
+		}
+
+		return http.StatusOK, map[string]interface{}{"status": "ok", "data": map[string]string{"resultId": "7001"}}
+	case "/api/v2/realty-result":
+		s.resultRequests++
+		status := realtyPending
+
+		if len(s.results) > 0 {
+			status = s.results[0]
+			s.results = s.results[1:]
+		}
+
+		if status == "" {
+			return http.StatusTooManyRequests, nil
+		}
+
+		data := map[string]string{"status": status}
+
+		if status != realtyPending {
+			data["pdf_link"] = s.pdfLink
+		}
+
+		return http.StatusOK, map[string]interface{}{"status": "ok", "data": data}
+	}
+
+	return http.StatusNotFound, nil
+}
+
+func testRealtyPollOptions() RealtyPollOptions {
+	return RealtyPollOptions{Interval: time.Millisecond, MaxInterval: 4 * time.Millisecond, MaxWait: time.Second}
+}
+
+func TestRunRealtyExtract(t *testing.T) {
+	server := &realtyServer{orders: []int{0, 0}, results: []string{"", realtyPending, "", "done"}, pdfLink: "https://example.com/7001.pdf"}
+	client := newTestClient(t, server.handle)
+	job := NewRealtyExtractJobByNumber("1234567890:12:345:6789")
+
+	if err := client.RunRealtyExtract(context.Background(), job, testRealtyPollOptions()); err != nil {
+		t.Fatal(err)
+	}
+
+	if job.Stage != RealtyJobReady || job.ResultId != "7001" || job.PdfLink != server.pdfLink || job.Checks != 2 {
+		t.Errorf("job %+v", job)
+	}
+
+	if server.orderRequests != 3 || server.resultRequests != 4 {
+		t.Errorf("%d orders, %d checks", server.orderRequests, server.resultRequests)
+	}
+
+	if err := client.RunRealtyExtract(context.Background(), job, testRealtyPollOptions()); err != nil || server.resultRequests != 4 {
+		t.Errorf("ready job was run again: %v", err)
+	}
+}
+
+func TestRunRealtyExtractResumes(t *testing.T) {
+	server := &realtyServer{pdfLink: "https://example.com/7001.pdf"}
+	client := newTestClient(t, server.handle)
+	job := NewRealtyExtractJobByNumber("1234567890:12:345:6789")
+	options := testRealtyPollOptions()
+	options.MaxWait = 10 * time.Millisecond
+
+	if err := client.RunRealtyExtract(context.Background(), job, options); !errors.Is(err, ErrRealtyExtractPending) {
+		t.Fatalf("got %v", err)
+	}
+
+	if job.Stage != RealtyJobOrdered || job.Checks == 0 {
+		t.Fatalf("job %+v", job)
+	}
+
+	data, err := json.Marshal(job)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var resumed RealtyExtractJob
+
+	if err = json.Unmarshal(data, &resumed); err != nil {
+		t.Fatal(err)
+	}
+
+	server.results = []string{"done"}
+
+	if err = client.RunRealtyExtract(context.Background(), &resumed, options); err != nil {
+		t.Fatal(err)
+	}
+
+	if resumed.Stage != RealtyJobReady || server.orderRequests != 1 {
+		t.Errorf("job %+v, %d orders", resumed, server.orderRequests)
+	}
+}
+
+func TestRunRealtyExtractFailed(t *testing.T) {
+	server := &realtyServer{results: []string{"error"}}
+	client := newTestClient(t, server.handle)
+	job := NewRealtyExtractJobByNumber("1234567890:12:345:6789")
+
+	err := client.RunRealtyExtract(context.Background(), job, testRealtyPollOptions())
+
+	if err == nil || job.Stage != RealtyJobFailed {
+		t.Fatalf("job %+v, %v", job, err)
+	}
+
+	again := client.RunRealtyExtract(context.Background(), job, testRealtyPollOptions())
+
+	if again == nil || again.Error() != err.Error() || server.resultRequests != 1 {
+		t.Errorf("failed job was run again: %v", again)
+	}
+}
+
+func TestRealtyBackoff(t *testing.T) {
+	backoff := newRealtyBackoff(RealtyPollOptions{Interval: time.Millisecond, MaxInterval: 3 * time.Millisecond})
+
+	for _, want := range []time.Duration{2 * time.Millisecond, 3 * time.Millisecond, 3 * time.Millisecond} {
+		if err := backoff.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+
+		if backoff.interval != want {
+			t.Errorf("interval %v, want %v", backoff.interval, want)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := backoff.wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("wait after cancel: %v", err)
+	}
+
+	expired := newRealtyBackoff(RealtyPollOptions{Interval: time.Millisecond, MaxWait: time.Nanosecond})
+	time.Sleep(time.Millisecond)
+
+	if err := expired.wait(context.Background()); !errors.Is(err, ErrRealtyExtractPending) {
+		t.Errorf("wait after deadline: %v", err)
+	}
+}
@@ -0,0 +1,359 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Region is a region identifier used by region_id parameters and results
+type Region int
+
+const (
+	RegionCrimea         Region = 1  // Автономна Республіка Крим
+	RegionVinnytsia      Region = 2  // Вінницька обл
+	RegionVolyn          Region = 3  // Волинська обл
+	RegionDnipropetrovsk Region = 4  // Дніпропетровська обл
+	RegionDonetsk        Region = 5  // Донецька обл
+	RegionZhytomyr       Region = 6  // Житомирська обл
+	RegionZakarpattia    Region = 7  // Закарпатська обл
+	RegionZaporizhzhia   Region = 8  // Запорізька обл
+	RegionIvanoFrankivsk Region = 9  // Івано-Франківська обл
+	RegionKyivOblast     Region = 10 // Київська обл
+	RegionKirovohrad     Region = 11 // Кіровоградська обл
+	RegionLuhansk        Region = 12 // Луганська обл
+	RegionLviv           Region = 13 // Львівська обл
+	RegionMykolaiv       Region = 14 // Миколаївська обл
+	RegionOdesa          Region = 15 // Одеська обл
+	RegionPoltava        Region = 16 // Полтавська обл
+	RegionRivne          Region = 17 // Рівненська обл
+	RegionSumy           Region = 18 // Сумська обл
+	RegionTernopil       Region = 19 // Тернопільська обл
+	RegionKharkiv        Region = 20 // Харківська обл
+	RegionKherson        Region = 21 // Херсонська обл
+	RegionKhmelnytskyi   Region = 22 // Хмельницька обл
+	RegionCherkasy       Region = 23 // Черкаська обл
+	RegionChernivtsi     Region = 24 // Чернівецька обл
+	RegionChernihiv      Region = 25 // Чернігівська обл
+	RegionKyiv           Region = 26 // м.Київ
+	RegionSevastopol     Region = 27 // м.Севастополь
+)
+
+type regionInfo struct {
+	name    string  // Назва як у документації API
+	short   string  // Назва без "обл"
+	latin   string  // Назва латиницею
+	koatuu  string  // Код КОАТУУ регіону
+	katottg KATOTTG // Код КАТОТТГ регіону
+}
+
+var regions = map[Region]regionInfo{
+	RegionCrimea:         {"Автономна Республіка Крим", "Автономна Республіка Крим", "Avtonomna Respublika Krym", "0100000000", "UA01000000000013043"},
+	RegionVinnytsia:      {"Вінницька обл", "Вінницька", "Vinnytska", "0500000000", "UA05000000000010236"},
+	RegionVolyn:          {"Волинська обл", "Волинська", "Volynska", "0700000000", "UA07000000000024379"},
+	RegionDnipropetrovsk: {"Дніпропетровська обл", "Дніпропетровська", "Dnipropetrovska", "1200000000", "UA12000000000090473"},
+	RegionDonetsk:        {"Донецька обл", "Донецька", "Donetska", "1400000000", "UA14000000000091971"},
+	RegionZhytomyr:       {"Житомирська обл", "Житомирська", "Zhytomyrska", "1800000000", "UA18000000000041385"},
+	RegionZakarpattia:    {"Закарпатська обл", "Закарпатська", "Zakarpatska", "2100000000", "UA21000000000011690"},
+	RegionZaporizhzhia:   {"Запорізька обл", "Запорізька", "Zaporizka", "2300000000", "UA23000000000064947"},
+	RegionIvanoFrankivsk: {"Івано-Франківська обл", "Івано-Франківська", "Ivano-Frankivska", "2600000000", "UA26000000000069363"},
+	RegionKyivOblast:     {"Київська обл", "Київська", "Kyivska", "3200000000", "UA32000000000030281"},
+	RegionKirovohrad:     {"Кіровоградська обл", "Кіровоградська", "Kirovohradska", "3500000000", "UA35000000000016081"},
+	RegionLuhansk:        {"Луганська обл", "Луганська", "Luhanska", "4400000000", "UA44000000000018890"},
+	RegionLviv:           {"Львівська обл", "Львівська", "Lvivska", "4600000000", "UA46000000000026241"},
+	RegionMykolaiv:       {"Миколаївська обл", "Миколаївська", "Mykolaivska", "4800000000", "UA48000000000039575"},
+	RegionOdesa:          {"Одеська обл", "Одеська", "Odeska", "5100000000", "UA51000000000030770"},
+	RegionPoltava:        {"Полтавська обл", "Полтавська", "Poltavska", "5300000000", "UA53000000000028050"},
+	RegionRivne:          {"Рівненська обл", "Рівненська", "Rivnenska", "5600000000", "UA56000000000066151"},
+	RegionSumy:           {"Сумська обл", "Сумська", "Sumska", "5900000000", "UA59000000000057109"},
+	RegionTernopil:       {"Тернопільська обл", "Тернопільська", "Ternopilska", "6100000000", "UA61000000000060328"},
+	RegionKharkiv:        {"Харківська обл", "Харківська", "Kharkivska", "6300000000", "UA63000000000041885"},
+	RegionKherson:        {"Херсонська обл", "Херсонська", "Khersonska", "6500000000", "UA65000000000030969"},
+	RegionKhmelnytskyi:   {"Хмельницька обл", "Хмельницька", "Khmelnytska", "6800000000", "UA68000000000099709"},
+	RegionCherkasy:       {"Черкаська обл", "Черкаська", "Cherkaska", "7100000000", "UA71000000000010357"},
+	RegionChernivtsi:     {"Чернівецька обл", "Чернівецька", "Chernivetska", "7300000000", "UA73000000000044923"},
+	RegionChernihiv:      {"Чернігівська обл", "Чернігівська", "Chernihivska", "7400000000", "UA74000000000025378"},
+	RegionKyiv:           {"м.Київ", "Київ", "Kyiv", "8000000000", "UA80000000000093317"},
+	RegionSevastopol:     {"м.Севастополь", "Севастополь", "Sevastopol", "8500000000", "UA85000000000065278"},
+}
+
+// Regions lists all regions in the order of their identifiers
+var Regions = []Region{
+	RegionCrimea, RegionVinnytsia, RegionVolyn, RegionDnipropetrovsk, RegionDonetsk,
+	RegionZhytomyr, RegionZakarpattia, RegionZaporizhzhia, RegionIvanoFrankivsk, RegionKyivOblast,
+	RegionKirovohrad, RegionLuhansk, RegionLviv, RegionMykolaiv, RegionOdesa,
+	RegionPoltava, RegionRivne, RegionSumy, RegionTernopil, RegionKharkiv,
+	RegionKherson, RegionKhmelnytskyi, RegionCherkasy, RegionChernivtsi, RegionChernihiv,
+	RegionKyiv, RegionSevastopol,
+}
+
+// IsValid
+// Reports whether the region is documented by the API
+func (r Region) IsValid() bool {
+	_, ok := regions[r]
+
+	return ok
+}
+
+// Name
+// Ukrainian name as used by the API documentation, e.g. "Вінницька обл"
+func (r Region) Name() string {
+	return regions[r].name
+}
+
+// NameLatin
+// Latin name, e.g. "Vinnytska"
+func (r Region) NameLatin() string {
+	return regions[r].latin
+}
+
+// KoatuuCode
+// 10-digit KOATUU code of the region, as returned by GetKoatuuRegions
+func (r Region) KoatuuCode() string {
+	return regions[r].koatuu
+}
+
+// KatottgCode
+// KATOTTG code of the region, e.g. UA46000000000026241 for Lviv oblast
+func (r Region) KatottgCode() KATOTTG {
+	return regions[r].katottg
+}
+
+// Param
+// Value for region_id parameters
+func (r Region) Param() string {
+	return strconv.Itoa(int(r))
+}
+
+func (r Region) String() string {
+	if !r.IsValid() {
+		return fmt.Sprintf("Region(%d)", int(r))
+	}
+
+	return r.Name()
+}
+
+// regionNoise are words dropped from region names before comparison
+var regionNoise = map[string]bool{
+	"обл":     true,
+	"область": true,
+	"м":       true,
+	"місто":   true,
+	"oblast":  true,
+	"obl":     true,
+	"region":  true,
+	"city":    true,
+}
+
+// regionOblastWords mark the name of an oblast rather than of its center, e.g. "Kyiv oblast"
+var regionOblastWords = map[string]bool{
+	"обл":     true,
+	"область": true,
+	"oblast":  true,
+	"obl":     true,
+	"region":  true,
+}
+
+// normalizeRegionName
+// Lower case name without noise words, and whether it names an oblast
+func normalizeRegionName(name string) (string, bool) {
+	name = strings.ToLower(apostrophes.Replace(name))
+	name = strings.NewReplacer(".", " ", ",", " ").Replace(name)
+
+	var words []string
+
+	oblast := false
+
+	for _, word := range strings.Fields(name) {
+		oblast = oblast || regionOblastWords[word]
+
+		if !regionNoise[word] {
+			words = append(words, word)
+		}
+	}
+
+	return strings.Join(words, " "), oblast
+}
+
+var regionAliases = map[string]Region{
+	"ар крим":   RegionCrimea,
+	"крим":      RegionCrimea,
+	"crimea":    RegionCrimea,
+	"kiev":      RegionKyiv,
+	"kyiv city": RegionKyiv,
+}
+
+// regionNouns are the noun names of the regions, mostly their centers,
+// as in "Odesa" and "Vinnytsia oblast"
+var regionNouns = map[string]Region{
+	"вінниця":          RegionVinnytsia,
+	"vinnytsia":        RegionVinnytsia,
+	"волинь":           RegionVolyn,
+	"volyn":            RegionVolyn,
+	"луцьк":            RegionVolyn,
+	"lutsk":            RegionVolyn,
+	"дніпропетровськ":  RegionDnipropetrovsk,
+	"dnipropetrovsk":   RegionDnipropetrovsk,
+	"дніпро":           RegionDnipropetrovsk,
+	"dnipro":           RegionDnipropetrovsk,
+	"донецьк":          RegionDonetsk,
+	"donetsk":          RegionDonetsk,
+	"житомир":          RegionZhytomyr,
+	"zhytomyr":         RegionZhytomyr,
+	"закарпаття":       RegionZakarpattia,
+	"zakarpattia":      RegionZakarpattia,
+	"ужгород":          RegionZakarpattia,
+	"uzhhorod":         RegionZakarpattia,
+	"запоріжжя":        RegionZaporizhzhia,
+	"zaporizhzhia":     RegionZaporizhzhia,
+	"івано-франківськ": RegionIvanoFrankivsk,
+	"ivano-frankivsk":  RegionIvanoFrankivsk,
+	"кіровоград":       RegionKirovohrad,
+	"kirovohrad":       RegionKirovohrad,
+	"кропивницький":    RegionKirovohrad,
+	"kropyvnytskyi":    RegionKirovohrad,
+	"луганськ":         RegionLuhansk,
+	"luhansk":          RegionLuhansk,
+	"львів":            RegionLviv,
+	"lviv":             RegionLviv,
+	"миколаїв":         RegionMykolaiv,
+	"mykolaiv":         RegionMykolaiv,
+	"одеса":            RegionOdesa,
+	"odesa":            RegionOdesa,
+	"odessa":           RegionOdesa,
+	"полтава":          RegionPoltava,
+	"poltava":          RegionPoltava,
+	"рівне":            RegionRivne,
+	"rivne":            RegionRivne,
+	"суми":             RegionSumy,
+	"sumy":             RegionSumy,
+	"тернопіль":        RegionTernopil,
+	"ternopil":         RegionTernopil,
+	"харків":           RegionKharkiv,
+	"kharkiv":          RegionKharkiv,
+	"херсон":           RegionKherson,
+	"kherson":          RegionKherson,
+	"хмельницький":     RegionKhmelnytskyi,
+	"khmelnytskyi":     RegionKhmelnytskyi,
+	"черкаси":          RegionCherkasy,
+	"cherkasy":         RegionCherkasy,
+	"чернівці":         RegionChernivtsi,
+	"chernivtsi":       RegionChernivtsi,
+	"чернігів":         RegionChernihiv,
+	"chernihiv":        RegionChernihiv,
+}
+
+// ParseRegion
+// Resolves a region from its identifier, Ukrainian or Latin name,
+// e.g. "4", "Дніпропетровська обл", "dnipropetrovska oblast", "Odesa", "м. Київ".
+// Kyiv is the city unless the name says oblast: "Kyiv oblast" is Київська обл.
+func ParseRegion(name string) (Region, error) {
+	if id, err := strconv.Atoi(strings.TrimSpace(name)); err == nil {
+		if Region(id).IsValid() {
+			return Region(id), nil
+		}
+
+		return 0, fmt.Errorf("Unknown region %q", name)
+	}
+
+	normalized, oblast := normalizeRegionName(name)
+	region, ok := regionAliases[normalized]
+
+	if !ok {
+		region, ok = regionNouns[normalized]
+	}
+
+	for i := 0; !ok && i < len(Regions); i++ {
+		info := regions[Regions[i]]
+
+		if normalized == strings.ToLower(info.short) || normalized == strings.ToLower(info.latin) {
+			region, ok = Regions[i], true
+		}
+	}
+
+	if !ok {
+		return 0, fmt.Errorf("Unknown region %q", name)
+	}
+
+	if oblast && region == RegionKyiv {
+		return RegionKyivOblast, nil
+	}
+
+	return region, nil
+}
+
+// RegionByKoatuu
+// Resolves a region by KOATUU code of any of its objects (first two digits)
+func RegionByKoatuu(code string) (Region, bool) {
+	if len(code) < 2 {
+		return 0, false
+	}
+
+	for _, region := range Regions {
+		if regions[region].koatuu[:2] == code[:2] {
+			return region, true
+		}
+	}
+
+	return 0, false
+}
+
+// regionFromId
+// Region for a numeric identifier in string form, false for unknown values
+func regionFromId(id string) (Region, bool) {
+	value, err := strconv.Atoi(strings.TrimSpace(id))
+
+	if err != nil || !Region(value).IsValid() {
+		return 0, false
+	}
+
+	return Region(value), true
+}
+
+// ResolveRegion
+// Region of the new company or FOP
+func (i *RegistrationsItem) ResolveRegion() (Region, bool) {
+	return Region(i.RegionId), Region(i.RegionId).IsValid()
+}
+
+// ResolveRegion
+// Region of the company or FOP
+func (r *Registration) ResolveRegion() (Region, bool) {
+	return Region(r.RegionId), Region(r.RegionId).IsValid()
+}
+
+// ResolveRegion
+// Region of the inspection
+func (i *Inspection) ResolveRegion() (Region, bool) {
+	return regionFromId(i.Region)
+}
+
+// ResolveRegion
+// Region of the court
+func (i *InstitutionItem) ResolveRegion() (Region, bool) {
+	return regionFromId(i.RegionId)
+}
+
+// ResolveRegion
+// Region of the performer
+func (i *PerformerItem) ResolveRegion() (Region, bool) {
+	return regionFromId(i.RegionId)
+}
+
+// RegionsByCode
+// Maps KOATUU codes returned by GetKoatuuRegions to regions
+func (r *KoatuuRegions) RegionsByCode() map[string]Region {
+	result := map[string]Region{}
+
+	for _, item := range r.Data {
+		if region, ok := RegionByKoatuu(item.Code); ok {
+			result[item.Code] = region
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import "testing"
+
+func TestParseRegion(t *testing.T) {
+	tests := []struct {
+		name string
+		want Region
+	}{
+		{"4", RegionDnipropetrovsk},
+		{"26", RegionKyiv},
+		{"Дніпропетровська обл", RegionDnipropetrovsk},
+		{"Дніпропетровська область", RegionDnipropetrovsk},
+		{"dnipropetrovska oblast", RegionDnipropetrovsk},
+		{"Dnipro", RegionDnipropetrovsk},
+		{"Автономна Республіка Крим", RegionCrimea},
+		{"АР Крим", RegionCrimea},
+		{"Київська обл.", RegionKyivOblast},
+		{"Kyivska", RegionKyivOblast},
+		{"Kyiv oblast", RegionKyivOblast},
+		{"Kyiv Region", RegionKyivOblast},
+		{"Київ обл.", RegionKyivOblast},
+		{"Київська область", RegionKyivOblast},
+		{"м. Київ", RegionKyiv},
+		{"м.Київ", RegionKyiv},
+		{"Київ", RegionKyiv},
+		{"Kyiv", RegionKyiv},
+		{"Kiev", RegionKyiv},
+		{"Kyiv city", RegionKyiv},
+		{"м. Севастополь", RegionSevastopol},
+		{"Odesa", RegionOdesa},
+		{"Одеса", RegionOdesa},
+		{"Odesa oblast", RegionOdesa},
+		{"Одеська обл", RegionOdesa},
+		{"Vinnytsia", RegionVinnytsia},
+		{"Vinnytsia oblast", RegionVinnytsia},
+		{"Вінницька", RegionVinnytsia},
+		{"Volyn", RegionVolyn},
+		{"Zaporizhzhia oblast", RegionZaporizhzhia},
+		{"Ivano-Frankivsk", RegionIvanoFrankivsk},
+		{"Івано-Франківська обл", RegionIvanoFrankivsk},
+		{"Kropyvnytskyi", RegionKirovohrad},
+		{"Khmelnytskyi oblast", RegionKhmelnytskyi},
+		{"Chernihiv", RegionChernihiv},
+		{"Chernivtsi", RegionChernivtsi},
+	}
+
+	for _, test := range tests {
+		got, err := ParseRegion(test.name)
+
+		if err != nil || got != test.want {
+			t.Errorf("ParseRegion(%q) = %v %v, want %v", test.name, got, err, test.want)
+		}
+	}
+
+	for _, name := range []string{"", "0", "28", "Атлантида", "oblast"} {
+		if region, err := ParseRegion(name); err == nil {
+			t.Errorf("ParseRegion(%q) = %v, want an error", name, region)
+		}
+	}
+}
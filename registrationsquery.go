@@ -0,0 +1,199 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// registrationsParams are the query parameters SearchRegistrations may send to GetRegistrations
+var registrationsParams = []string{
+	"offset",
+	"limit",
+	"type",
+	"reg_date_from",
+	"reg_date_to",
+	"activities",
+	"location",
+	"is_phone",
+	"is_email",
+	"sort",
+}
+
+// EntityType is a kind of registered subject
+type EntityType string
+
+const (
+	EntityCompany EntityType = "company" // Юридична особа
+	EntityFop     EntityType = "fop"     // Фізична особа-підприємець
+)
+
+// IsValid
+// Reports whether the type is documented by the API
+func (t EntityType) IsValid() bool {
+	return t == EntityCompany || t == EntityFop
+}
+
+// SortOrder is a direction of sorting
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "ASC"  // За зростанням
+	SortDesc SortOrder = "DESC" // За спаданням
+)
+
+// IsValid
+// Reports whether the order is documented by the API
+func (o SortOrder) IsValid() bool {
+	return o == SortAsc || o == SortDesc
+}
+
+// kvedCodePattern matches KVED division, group and class codes: 62, 62.0, 62.01
+var kvedCodePattern = regexp.MustCompile(`^\d{2}(\.\d{1,2})?$`)
+
+// orSeparator joins alternatives in activities and location filters
+const orSeparator = " OR "
+
+// RegistrationsQuery is a typed set of GetRegistrations parameters.
+// Zero values are omitted.
+type RegistrationsQuery struct {
+	Type       EntityType // Юридична (company) або фізична (fop) особа
+	DateFrom   time.Time  // Пошук за датою реєстрації з, за київським часом
+	DateTo     time.Time  // Пошук за датою реєстрації по, за київським часом
+	Activities []string   // Коди КВЕД, наприклад 69 або 62.01
+	Locations  []string   // Частини адреси, наприклад Дніпро
+	IsPhone    *bool      // З телефоном (true) або без нього (false), nil без фільтра
+	IsEmail    *bool      // З email (true) або без нього (false), nil без фільтра
+	Sort       SortOrder  // Спосіб сортування
+	Offset     int        // Зміщення відносно початку результатів пошуку
+	Limit      int        // Кількість записів
+}
+
+// Validate
+// Checks enum values, KVED codes, locations, date bounds and paging
+func (q *RegistrationsQuery) Validate() error {
+	if q.Type != "" && !q.Type.IsValid() {
+		return fmt.Errorf("Unknown entity type %s", q.Type)
+	}
+
+	if q.Sort != "" && !q.Sort.IsValid() {
+		return fmt.Errorf("Unknown sort order %s", q.Sort)
+	}
+
+	for _, code := range q.Activities {
+		if !kvedCodePattern.MatchString(strings.TrimSpace(code)) {
+			return fmt.Errorf("Invalid KVED code %q", code)
+		}
+	}
+
+	for _, location := range q.Locations {
+		location = strings.TrimSpace(location)
+
+		if location == "" {
+			return errors.New("Location is empty")
+		}
+
+		if strings.Contains(strings.ToUpper(" "+location+" "), orSeparator) {
+			return fmt.Errorf("Location %q must not contain OR", location)
+		}
+	}
+
+	if !q.DateFrom.IsZero() && !q.DateTo.IsZero() && q.DateFrom.After(q.DateTo) {
+		return errors.New("DateFrom is after DateTo")
+	}
+
+	if q.Offset < 0 || q.Limit < 0 {
+		return errors.New("Offset and limit must not be negative")
+	}
+
+	return nil
+}
+
+// joinOr
+// Trims values and joins them with " OR "
+func joinOr(values []string) string {
+	trimmed := make([]string, 0, len(values))
+
+	for _, value := range values {
+		trimmed = append(trimmed, strings.TrimSpace(value))
+	}
+
+	return strings.Join(trimmed, orSeparator)
+}
+
+// flagParam
+// "1" or "0" for a set filter, "" for nil
+func flagParam(value *bool) string {
+	switch {
+	case value == nil:
+		return ""
+	case *value:
+		return "1"
+	}
+
+	return "0"
+}
+
+// Params
+// Query parameters for GetRegistrations
+func (q *RegistrationsQuery) Params() (params map[string]string, err error) {
+	if err = q.Validate(); err != nil {
+		return nil, err
+	}
+
+	params = map[string]string{}
+
+	setParam(params, "type", string(q.Type))
+	setParam(params, "activities", joinOr(q.Activities))
+	setParam(params, "location", joinOr(q.Locations))
+	setParam(params, "sort", string(q.Sort))
+	setParam(params, "reg_date_from", kyivDate(q.DateFrom))
+	setParam(params, "reg_date_to", kyivDate(q.DateTo))
+	setParam(params, "is_phone", flagParam(q.IsPhone))
+	setParam(params, "is_email", flagParam(q.IsEmail))
+
+	if q.Offset != 0 {
+		params["offset"] = strconv.Itoa(q.Offset)
+	}
+
+	if q.Limit != 0 {
+		params["limit"] = strconv.Itoa(q.Limit)
+	}
+
+	return params, nil
+}
+
+// Encode
+// Query string sorted by key, so the same query always encodes the same way
+func (q *RegistrationsQuery) Encode() (string, error) {
+	params, err := q.Params()
+
+	if err != nil {
+		return "", err
+	}
+
+	return encodeParams(params), nil
+}
+
+// SearchRegistrations
+// Отримання переліку нових компаній та ФОПів за типізованим запитом
+func (odb *OdbClient) SearchRegistrations(query RegistrationsQuery) (*Registrations, error) {
+	params, err := query.Params()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkKnownParams(params, registrationsParams); err != nil {
+		return nil, err
+	}
+
+	return odb.GetRegistrations(params)
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRegistrationsQueryParams(t *testing.T) {
+	yes, no := true, false
+
+	query := RegistrationsQuery{
+		Type:       EntityFop,
+		DateFrom:   time.Date(2022, 2, 28, 22, 0, 0, 0, time.UTC),
+		DateTo:     time.Date(2022, 3, 31, 0, 0, 0, 0, Kyiv),
+		Activities: []string{"69", " 62.01 "},
+		Locations:  []string{"Дніпро", "київ"},
+		IsPhone:    &yes,
+		IsEmail:    &no,
+		Sort:       SortDesc,
+		Limit:      50,
+	}
+
+	params, err := query.Params()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"type":          "fop",
+		"reg_date_from": "2022-03-01",
+		"reg_date_to":   "2022-03-31",
+		"activities":    "69 OR 62.01",
+		"location":      "Дніпро OR київ",
+		"is_phone":      "1",
+		"is_email":      "0",
+		"sort":          "DESC",
+		"limit":         "50",
+	}
+
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("params %v, want %v", params, want)
+	}
+
+	if params, err = (&RegistrationsQuery{}).Params(); err != nil || len(params) != 0 {
+		t.Errorf("empty query params %v, %v", params, err)
+	}
+
+	invalid := []RegistrationsQuery{
+		{Type: "person"},
+		{Sort: "asc"},
+		{Activities: []string{"62.011"}},
+		{Locations: []string{" "}},
+		{Locations: []string{"Київ or Дніпро"}},
+		{DateFrom: time.Date(2022, 2, 1, 0, 0, 0, 0, Kyiv), DateTo: time.Date(2022, 1, 1, 0, 0, 0, 0, Kyiv)},
+		{Offset: -1},
+	}
+
+	for _, query := range invalid {
+		if _, err := query.Params(); err == nil {
+			t.Errorf("query %+v accepted", query)
+		}
+	}
+}
+
+func TestSearchRegistrations(t *testing.T) {
+	var query map[string]string
+
+	client := newTestClient(t, func(req *http.Request) (int, interface{}) {
+		query = map[string]string{}
+
+		for key := range req.URL.Query() {
+			query[key] = req.URL.Query().Get(key)
+		}
+
+		return http.StatusOK, map[string]interface{}{"status": "ok"}
+	})
+
+	no := false
+
+	if _, err := client.SearchRegistrations(RegistrationsQuery{Type: EntityCompany, IsPhone: &no}); err != nil {
+		t.Fatal(err)
+	}
+
+	if query["type"] != "company" || query["is_phone"] != "0" {
+		t.Errorf("query %v", query)
+	}
+
+	// raw parameters are passed as given, including ones added to the API later
+	if _, err := client.GetRegistrations(map[string]string{"type": "fop", "region": "12"}); err != nil || query["region"] != "12" {
+		t.Errorf("raw query %v, %v", query, err)
+	}
+}
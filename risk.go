@@ -0,0 +1,516 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RiskSignal is a kind of evidence extracted from counterparty data
+type RiskSignal string
+
+const (
+	RiskSignalCompanyStatus  RiskSignal = "company_status"  // Статус компанії, значення — CompanyData.Status
+	RiskSignalWagedebt       RiskSignal = "wagedebt"        // Заборгованість із заробітної плати, сума в гривнях
+	RiskSignalPenalty        RiskSignal = "penalty"         // Відкрите виконавче провадження, значення — категорія стягнення
+	RiskSignalTaxDebt        RiskSignal = "tax_debt"        // Податковий борг за останньою подією стрічки, сума в гривнях
+	RiskSignalSanction       RiskSignal = "sanction"        // Діюча санкція зі стрічки, значення — санкційний список
+	RiskSignalCriminalCases  RiskSignal = "criminal_cases"  // Кримінальні справи, кількість
+	RiskSignalInspectionRisk RiskSignal = "inspection_risk" // Перевірка, значення — рівень ризику
+)
+
+// RiskSignals are the signals known to the engine
+var RiskSignals = []RiskSignal{
+	RiskSignalCompanyStatus,
+	RiskSignalWagedebt,
+	RiskSignalPenalty,
+	RiskSignalTaxDebt,
+	RiskSignalSanction,
+	RiskSignalCriminalCases,
+	RiskSignalInspectionRisk,
+}
+
+// IsValid
+// Reports whether the signal is known to the engine
+func (s RiskSignal) IsValid() bool {
+	for _, signal := range RiskSignals {
+		if s == signal {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RiskEvidence is a record that may trigger a rule
+type RiskEvidence struct {
+	Signal RiskSignal  `json:"signal"`           // Сигнал
+	Value  string      `json:"value,omitempty"`  // Текстове значення
+	Amount float64     `json:"amount,omitempty"` // Числове значення
+	Date   *DateTime   `json:"date,omitempty"`   // Дата події, якщо відома
+	Record interface{} `json:"record"`           // Запис, з якого отримано сигнал
+}
+
+// RiskInput is the counterparty data the rules are evaluated over.
+// Missing sources are left nil and produce no evidence.
+type RiskInput struct {
+	Company     *CompanyData
+	Wagedebt    *Wagedebt
+	Penalties   *PenaltiesSuccess
+	Courts      *CompanyCourtsList
+	Inspections *InspectionsResponse
+	Timeline    *Timeline // Події debt та sanction
+	At          time.Time // Момент оцінки для строку дії санкцій, нульовий — поточний час
+}
+
+// RiskInput
+// Input of the risk engine built from the report, the timeline is optional
+func (r *CounterpartyReport) RiskInput(timeline *Timeline) RiskInput {
+	return RiskInput{
+		Company:     r.Company,
+		Wagedebt:    r.Wagedebt,
+		Penalties:   r.Penalties,
+		Courts:      r.Courts,
+		Inspections: r.Inspections,
+		Timeline:    timeline,
+	}
+}
+
+// Evidence
+// All evidence of the signal found in the input.
+// A record that cannot be read, e.g. a tax debt with an invalid amount, is an error.
+func (in RiskInput) Evidence(signal RiskSignal) (evidence []RiskEvidence, err error) {
+	add := func(value string, amount float64, date DateTime, record interface{}) {
+		item := RiskEvidence{Signal: signal, Value: value, Amount: amount, Record: record}
+
+		if !date.IsZero() {
+			item.Date = &date
+		}
+
+		evidence = append(evidence, item)
+	}
+
+	switch signal {
+	case RiskSignalCompanyStatus:
+		if in.Company != nil && in.Company.Status != "" {
+			add(in.Company.Status, 0, in.Company.DatabaseDate, *in.Company)
+		}
+	case RiskSignalWagedebt:
+		if in.Wagedebt != nil && in.Wagedebt.Debt > 0 {
+			add("", in.Wagedebt.Debt.Float64(), DateTime{in.Wagedebt.DatabaseDate.Time}, *in.Wagedebt)
+		}
+	case RiskSignalPenalty:
+		if in.Penalties != nil {
+			for _, item := range in.Penalties.Data.Items {
+				add(item.Category, 1, DateTime{}, item)
+			}
+		}
+	case RiskSignalTaxDebt:
+		if item, change, ok := in.latestTaxDebt(); ok {
+			amount, err := ParseMoney(change.NewValue)
+
+			if err != nil {
+				return nil, fmt.Errorf("Invalid tax debt amount %q: %w", change.NewValue, err)
+			}
+
+			add(change.NewValue, amount.Float64(), item.EventDate, item)
+		}
+	case RiskSignalSanction:
+		for _, item := range in.timelineItems("sanction") {
+			for _, change := range item.Change {
+				if in.sanctionActive(change) {
+					add(change.SanctionList, 1, item.EventDate, item)
+				}
+			}
+		}
+	case RiskSignalCriminalCases:
+		if in.Courts != nil && in.Courts.Criminal.Count > 0 {
+			add("", float64(in.Courts.Criminal.Count), DateTime{}, *in.Courts)
+		}
+	case RiskSignalInspectionRisk:
+		if in.Inspections != nil {
+			for _, item := range in.Inspections.Data.Items {
+				add(item.Risk, float64(item.ViolationsCount), item.DateStart, item)
+			}
+		}
+	}
+
+	return evidence, nil
+}
+
+func (in RiskInput) timelineItems(eventType string) (items []TimelineItem) {
+	if in.Timeline == nil {
+		return nil
+	}
+
+	for _, item := range in.Timeline.Data.Items {
+		if item.Type == eventType {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// latestTaxDebt
+// The most recent debt event, earlier ones are superseded by it
+func (in RiskInput) latestTaxDebt() (latest TimelineItem, change TimelineChange, ok bool) {
+	for _, item := range in.timelineItems("debt") {
+		if len(item.Change) == 0 || (ok && !item.EventDate.After(latest.EventDate.Time)) {
+			continue
+		}
+
+		latest, change, ok = item, item.Change[0], true
+	}
+
+	return latest, change, ok
+}
+
+func (in RiskInput) sanctionActive(change TimelineChange) bool {
+	at := in.At
+
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	return change.Termless.Bool() || change.EndDate.IsZero() || !change.EndDate.Before(at)
+}
+
+// RiskRule is a weighted condition over the evidence of one signal
+type RiskRule struct {
+	Id          string     `json:"id" yaml:"id"`                                         // Ідентифікатор правила
+	Description string     `json:"description,omitempty" yaml:"description,omitempty"`   // Опис для аудитора
+	Signal      RiskSignal `json:"signal" yaml:"signal"`                                 // Сигнал
+	Values      []string   `json:"values,omitempty" yaml:"values,omitempty"`             // Спрацьовує на значення зі списку, без урахування регістру
+	Min         *float64   `json:"min,omitempty" yaml:"min,omitempty"`                   // Спрацьовує на суму, не меншу за min
+	Weight      float64    `json:"weight" yaml:"weight"`                                 // Бали за спрацювання
+	PerEvidence bool       `json:"per_evidence,omitempty" yaml:"per_evidence,omitempty"` // Бали нараховуються за кожен запис
+	Max         float64    `json:"max,omitempty" yaml:"max,omitempty"`                   // Обмеження балів правила, 0 — без обмеження
+}
+
+// Matches
+// Reports whether the evidence satisfies the rule conditions.
+// A rule without values and min matches any evidence of its signal.
+func (r RiskRule) Matches(evidence RiskEvidence) bool {
+	if evidence.Signal != r.Signal {
+		return false
+	}
+
+	if len(r.Values) > 0 {
+		matched := false
+
+		for _, value := range r.Values {
+			if strings.EqualFold(strings.TrimSpace(value), strings.TrimSpace(evidence.Value)) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return r.Min == nil || evidence.Amount >= *r.Min
+}
+
+// RiskBand is a named score range starting at Min
+type RiskBand struct {
+	Name string  `json:"name" yaml:"name"` // Назва, наприклад high
+	Min  float64 `json:"min" yaml:"min"`   // Мінімальний бал
+}
+
+// RiskRules is a configuration of the risk engine.
+//
+//	bands:
+//	  - {name: low, min: 0}
+//	  - {name: medium, min: 30}
+//	  - {name: high, min: 60}
+//	rules:
+//	  - id: bankruptcy
+//	    signal: company_status
+//	    values: ["порушено справу про банкрутство"]
+//	    weight: 60
+//	  - id: penalties
+//	    signal: penalty
+//	    weight: 5
+//	    per_evidence: true
+//	    max: 25
+type RiskRules struct {
+	Bands []RiskBand `json:"bands" yaml:"bands"`
+	Rules []RiskRule `json:"rules" yaml:"rules"`
+}
+
+func floatPtr(value float64) *float64 {
+	return &value
+}
+
+// DefaultRiskRules
+// Rules used when no configuration is given
+func DefaultRiskRules() *RiskRules {
+	return &RiskRules{
+		Bands: []RiskBand{
+			{Name: "low", Min: 0},
+			{Name: "medium", Min: 30},
+			{Name: "high", Min: 60},
+		},
+		Rules: []RiskRule{
+			{
+				Id:          "bankruptcy",
+				Description: "Порушено справу про банкрутство",
+				Signal:      RiskSignalCompanyStatus,
+				Values:      []string{"порушено справу про банкрутство", "порушено справу про банкрутство (санація)"},
+				Weight:      60,
+			},
+			{
+				Id:          "termination",
+				Description: "Компанія припинена або в стані припинення",
+				Signal:      RiskSignalCompanyStatus,
+				Values:      []string{"в стані припинення", "припинено"},
+				Weight:      60,
+			},
+			{
+				Id:          "invalid_registration",
+				Description: "Свідоцтво про державну реєстрацію недійсне",
+				Signal:      RiskSignalCompanyStatus,
+				Values:      []string{"зареєстровано, свідоцтво про державну реєстрацію недійсне"},
+				Weight:      40,
+			},
+			{
+				Id:          "sanction",
+				Description: "Діюча санкція",
+				Signal:      RiskSignalSanction,
+				Weight:      100,
+			},
+			{
+				Id:          "wagedebt",
+				Description: "Заборгованість із заробітної плати",
+				Signal:      RiskSignalWagedebt,
+				Min:         floatPtr(0.01),
+				Weight:      20,
+			},
+			{
+				Id:          "tax_debt",
+				Description: "Податковий борг",
+				Signal:      RiskSignalTaxDebt,
+				Min:         floatPtr(0.01),
+				Weight:      20,
+			},
+			{
+				Id:          "penalties",
+				Description: "Відкриті виконавчі провадження",
+				Signal:      RiskSignalPenalty,
+				Weight:      5,
+				PerEvidence: true,
+				Max:         25,
+			},
+			{
+				Id:          "criminal_cases",
+				Description: "Кримінальні справи",
+				Signal:      RiskSignalCriminalCases,
+				Min:         floatPtr(1),
+				Weight:      15,
+			},
+			{
+				Id:          "inspection_high_risk",
+				Description: "Перевірки суб'єкта з високим ступенем ризику",
+				Signal:      RiskSignalInspectionRisk,
+				Values:      []string{"Високий"},
+				Weight:      10,
+			},
+		},
+	}
+}
+
+// Validate
+// Checks rule ids, signals and bands
+func (rules *RiskRules) Validate() error {
+	if len(rules.Bands) == 0 {
+		return errors.New("Risk bands are not specified")
+	}
+
+	ids := map[string]bool{}
+
+	for _, rule := range rules.Rules {
+		if rule.Id == "" {
+			return errors.New("Risk rule id is not specified")
+		}
+
+		if ids[rule.Id] {
+			return fmt.Errorf("Duplicate risk rule %s", rule.Id)
+		}
+
+		ids[rule.Id] = true
+
+		if !rule.Signal.IsValid() {
+			return fmt.Errorf("Unknown risk signal %s in rule %s", rule.Signal, rule.Id)
+		}
+
+		if rule.Max < 0 {
+			return fmt.Errorf("Invalid max %v in rule %s", rule.Max, rule.Id)
+		}
+	}
+
+	for _, band := range rules.Bands {
+		if band.Name == "" {
+			return errors.New("Risk band name is not specified")
+		}
+	}
+
+	return nil
+}
+
+// ParseRiskRules
+// Parses risk rules from JSON or YAML
+func ParseRiskRules(data []byte) (*RiskRules, error) {
+	var rules RiskRules
+
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &rules, nil
+}
+
+// LoadRiskRules
+// Reads risk rules from a .json, .yaml or .yml file
+func LoadRiskRules(path string) (*RiskRules, error) {
+	data, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(filepath.Ext(path), ".json") {
+		return ParseRiskRules(data)
+	}
+
+	var rules RiskRules
+
+	if err = json.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+
+	if err = rules.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &rules, nil
+}
+
+// FiredRule is a rule that matched, with the evidence that triggered it
+type FiredRule struct {
+	Rule     RiskRule       `json:"rule"`
+	Points   float64        `json:"points"`   // Нараховані бали
+	Evidence []RiskEvidence `json:"evidence"` // Записи, на які спрацювало правило
+}
+
+// RiskAssessment is the explained outcome of the risk engine
+type RiskAssessment struct {
+	Score  float64              `json:"score"` // Сума балів
+	Band   string               `json:"band"`  // Рівень ризику, порожній якщо бал нижчий за всі рівні
+	Fired  []FiredRule          `json:"fired"` // Правила, що спрацювали, у порядку конфігурації
+	Errors map[RiskSignal]error `json:"-"`     // Сигнали, які не вдалося отримати з даних
+}
+
+// String
+// Short human readable explanation, one fired rule per line
+func (a *RiskAssessment) String() string {
+	var text strings.Builder
+
+	band := a.Band
+
+	if band == "" {
+		band = "no band"
+	}
+
+	fmt.Fprintf(&text, "%s (%s)", band, strconv.FormatFloat(a.Score, 'f', -1, 64))
+
+	for _, fired := range a.Fired {
+		fmt.Fprintf(&text, "\n+%s %s: %d record(s)", strconv.FormatFloat(fired.Points, 'f', -1, 64), fired.Rule.Id, len(fired.Evidence))
+	}
+
+	return text.String()
+}
+
+// Evaluate
+// Scores the input: every rule collects the matching evidence of its signal,
+// fired rules add their weight (per record when PerEvidence is set, capped by Max),
+// and the band is the one with the greatest Min not above the score;
+// a score below every band leaves Band empty.
+// Signals that cannot be read fire no rules and are kept in Errors.
+func (rules *RiskRules) Evaluate(input RiskInput) *RiskAssessment {
+	assessment := &RiskAssessment{}
+	evidence := map[RiskSignal][]RiskEvidence{}
+
+	for _, rule := range rules.Rules {
+		if _, ok := evidence[rule.Signal]; !ok {
+			items, err := input.Evidence(rule.Signal)
+
+			if err != nil {
+				if assessment.Errors == nil {
+					assessment.Errors = map[RiskSignal]error{}
+				}
+
+				assessment.Errors[rule.Signal] = err
+			}
+
+			evidence[rule.Signal] = items
+		}
+
+		var matched []RiskEvidence
+
+		for _, item := range evidence[rule.Signal] {
+			if rule.Matches(item) {
+				matched = append(matched, item)
+			}
+		}
+
+		if len(matched) == 0 {
+			continue
+		}
+
+		points := rule.Weight
+
+		if rule.PerEvidence {
+			points *= float64(len(matched))
+		}
+
+		if rule.Max > 0 && points > rule.Max {
+			points = rule.Max
+		}
+
+		assessment.Score += points
+		assessment.Fired = append(assessment.Fired, FiredRule{Rule: rule, Points: points, Evidence: matched})
+	}
+
+	bands := append([]RiskBand(nil), rules.Bands...)
+
+	sort.SliceStable(bands, func(i, j int) bool {
+		return bands[i].Min < bands[j].Min
+	})
+
+	for _, band := range bands {
+		if assessment.Score >= band.Min {
+			assessment.Band = band.Name
+		}
+	}
+
+	return assessment
+}
@@ -0,0 +1,188 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func riskDate(year int, month time.Month, day int) DateTime {
+	return DateTime{time.Date(year, month, day, 0, 0, 0, 0, Kyiv)}
+}
+
+func riskTimeline(items ...TimelineItem) *Timeline {
+	timeline := &Timeline{}
+	timeline.Data.Items = items
+
+	return timeline
+}
+
+func riskPenalties(count int) *PenaltiesSuccess {
+	penalties := &PenaltiesSuccess{}
+
+	for i := 0; i < count; i++ {
+		penalties.Data.Items = append(penalties.Data.Items, PenaltyItem{Category: "стягнення коштів"})
+	}
+
+	return penalties
+}
+
+func TestDefaultRiskRules(t *testing.T) {
+	at := time.Date(2022, 6, 1, 0, 0, 0, 0, Kyiv)
+	courts := &CompanyCourtsList{}
+	courts.Criminal.Count = 2
+	inspections := &InspectionsResponse{}
+	inspections.Data.Items = []Inspection{{Risk: "Високий"}, {Risk: "Низький"}}
+
+	tests := []struct {
+		name  string
+		input RiskInput
+		fired []string
+		score float64
+		band  string
+	}{
+		{"clean", RiskInput{Company: &CompanyData{Status: "зареєстровано"}}, nil, 0, "low"},
+		{"bankruptcy", RiskInput{Company: &CompanyData{Status: "порушено справу про банкрутство (санація)"}}, []string{"bankruptcy"}, 60, "high"},
+		{"termination", RiskInput{Company: &CompanyData{Status: "Припинено"}}, []string{"termination"}, 60, "high"},
+		{"invalid_registration", RiskInput{Company: &CompanyData{Status: "зареєстровано, свідоцтво про державну реєстрацію недійсне"}}, []string{"invalid_registration"}, 40, "medium"},
+		{"sanction", RiskInput{At: at, Timeline: riskTimeline(TimelineItem{Type: "sanction", EventDate: riskDate(2021, 1, 1),
+			Change: []TimelineChange{{SanctionList: "РНБО", EndDate: Date{at.AddDate(1, 0, 0)}}}})}, []string{"sanction"}, 100, "high"},
+		{"expired sanction", RiskInput{At: at, Timeline: riskTimeline(TimelineItem{Type: "sanction",
+			Change: []TimelineChange{{SanctionList: "РНБО", EndDate: Date{at.AddDate(0, 0, -1)}}}})}, nil, 0, "low"},
+		{"wagedebt", RiskInput{Wagedebt: &Wagedebt{Debt: 1368243}}, []string{"wagedebt"}, 20, "low"},
+		{"tax_debt", RiskInput{Timeline: riskTimeline(
+			TimelineItem{Type: "debt", EventDate: riskDate(2021, 1, 1), Change: []TimelineChange{{NewValue: "37 334"}}},
+			TimelineItem{Type: "debt", EventDate: riskDate(2022, 1, 1), Change: []TimelineChange{{NewValue: "3861.00"}}},
+		)}, []string{"tax_debt"}, 20, "low"},
+		{"tax debt paid", RiskInput{Timeline: riskTimeline(
+			TimelineItem{Type: "debt", EventDate: riskDate(2021, 1, 1), Change: []TimelineChange{{NewValue: "37 334"}}},
+			TimelineItem{Type: "debt", EventDate: riskDate(2022, 1, 1), Change: []TimelineChange{{NewValue: "0"}}},
+		)}, nil, 0, "low"},
+		{"penalties", RiskInput{Penalties: riskPenalties(3)}, []string{"penalties"}, 15, "low"},
+		{"penalties capped", RiskInput{Penalties: riskPenalties(8)}, []string{"penalties"}, 25, "low"},
+		{"criminal_cases", RiskInput{Courts: courts}, []string{"criminal_cases"}, 15, "low"},
+		{"inspection_high_risk", RiskInput{Inspections: inspections}, []string{"inspection_high_risk"}, 10, "low"},
+		{"medium boundary", RiskInput{Wagedebt: &Wagedebt{Debt: 100}, Penalties: riskPenalties(2)}, []string{"wagedebt", "penalties"}, 30, "medium"},
+	}
+
+	rules := DefaultRiskRules()
+
+	if err := rules.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range tests {
+		assessment := rules.Evaluate(test.input)
+
+		var fired []string
+
+		for _, rule := range assessment.Fired {
+			fired = append(fired, rule.Rule.Id)
+		}
+
+		if assessment.Score != test.score || assessment.Band != test.band || len(fired) != len(test.fired) || len(assessment.Errors) != 0 {
+			t.Errorf("%s: %s, errors %v", test.name, assessment, assessment.Errors)
+			continue
+		}
+
+		for i := range fired {
+			if fired[i] != test.fired[i] {
+				t.Errorf("%s: fired %v, want %v", test.name, fired, test.fired)
+			}
+		}
+	}
+}
+
+func TestRiskBands(t *testing.T) {
+	rules := &RiskRules{
+		Bands: []RiskBand{{Name: "high", Min: 60}, {Name: "medium", Min: 10}},
+		Rules: []RiskRule{{Id: "penalties", Signal: RiskSignalPenalty, Weight: 5, PerEvidence: true}},
+	}
+
+	tests := []struct {
+		penalties int
+		band      string
+	}{
+		{0, ""},
+		{1, ""},
+		{2, "medium"},
+		{11, "medium"},
+		{12, "high"},
+		{20, "high"},
+	}
+
+	for _, test := range tests {
+		if assessment := rules.Evaluate(RiskInput{Penalties: riskPenalties(test.penalties)}); assessment.Band != test.band {
+			t.Errorf("%d penalties: band %q, want %q", test.penalties, assessment.Band, test.band)
+		}
+	}
+}
+
+func TestRiskEvidence(t *testing.T) {
+	input := RiskInput{Timeline: riskTimeline(TimelineItem{Type: "debt", Change: []TimelineChange{{NewValue: "багато"}}})}
+
+	if _, err := input.Evidence(RiskSignalTaxDebt); err == nil {
+		t.Error("invalid tax debt amount was accepted")
+	}
+
+	if assessment := DefaultRiskRules().Evaluate(input); assessment.Errors[RiskSignalTaxDebt] == nil || assessment.Score != 0 {
+		t.Errorf("assessment %s, errors %v", assessment, assessment.Errors)
+	}
+
+	evidence, err := RiskInput{Penalties: riskPenalties(1)}.Evidence(RiskSignalPenalty)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encoded, err := json.Marshal(evidence[0])
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fields map[string]interface{}
+
+	if err = json.Unmarshal(encoded, &fields); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := fields["date"]; ok {
+		t.Errorf("evidence without a date encodes it: %s", encoded)
+	}
+}
+
+func TestParseRiskRules(t *testing.T) {
+	rules, err := ParseRiskRules([]byte(`
+bands:
+  - {name: low, min: 0}
+  - {name: high, min: 50}
+rules:
+  - id: bankruptcy
+    signal: company_status
+    values: ["порушено справу про банкрутство"]
+    weight: 60
+`))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if assessment := rules.Evaluate(RiskInput{Company: &CompanyData{Status: "порушено справу про банкрутство"}}); assessment.Band != "high" {
+		t.Errorf("assessment %s", assessment)
+	}
+
+	for _, data := range []string{
+		`rules: [{id: a, signal: penalty, weight: 1}]`,
+		`{bands: [{name: low, min: 0}], rules: [{id: a, signal: unknown, weight: 1}]}`,
+		`{bands: [{name: low, min: 0}], rules: [{id: a, signal: penalty}, {id: a, signal: penalty}]}`,
+	} {
+		if _, err := ParseRiskRules([]byte(data)); err == nil {
+			t.Errorf("rules accepted: %s", data)
+		}
+	}
+}
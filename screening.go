@@ -0,0 +1,397 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// screeningLimit is the number of records requested from every source
+const screeningLimit = 100
+
+// ScreeningSource is a registry checked by ScreenPerson
+type ScreeningSource string
+
+const (
+	ScreeningWanted           ScreeningSource = "wanted"            // GetWanted
+	ScreeningCorruptOfficials ScreeningSource = "corrupt_officials" // GetCorruptOfficials
+	ScreeningAliment          ScreeningSource = "aliment"           // GetAliment
+	ScreeningPassport         ScreeningSource = "passport"          // GetPassport
+	ScreeningPenalties        ScreeningSource = "penalties"         // GetPenalties
+	ScreeningPenaltiesByCode  ScreeningSource = "penalties_by_code" // GetPenaltiesByCode
+	ScreeningAccused          ScreeningSource = "accused"           // GetAccused
+	ScreeningLawyers          ScreeningSource = "lawyers"           // GetLawyers
+)
+
+// PersonQuery is an applicant to screen
+type PersonQuery struct {
+	LastName   string    // Прізвище
+	FirstName  string    // Ім'я
+	MiddleName string    // По батькові
+	BirthDate  time.Time // Дата народження, необов'язкова якщо вказано РНОКПП
+	Passport   string    // Номер паспорта, необов'язковий
+	Rnokpp     RNOKPP    // РНОКПП (ІПН), необов'язковий
+}
+
+// FullName
+// ПІБ in the "Прізвище Ім'я По батькові" order used by searches
+func (q PersonQuery) FullName() string {
+	return q.Name().String()
+}
+
+// Name
+// Normalized name of the applicant
+func (q PersonQuery) Name() PersonName {
+	return NewPersonName(q.LastName, q.FirstName, q.MiddleName)
+}
+
+// Validate
+// Checks the name and the RNOKPP, and that the RNOKPP agrees with the birth date
+func (q PersonQuery) Validate() error {
+	if strings.TrimSpace(q.LastName) == "" || strings.TrimSpace(q.FirstName) == "" {
+		return errors.New("Last name and first name are required")
+	}
+
+	if q.Rnokpp == "" {
+		return nil
+	}
+
+	rnokpp, err := ParseRNOKPP(string(q.Rnokpp))
+
+	if err != nil {
+		return err
+	}
+
+	if !q.BirthDate.IsZero() && !sameDay(q.BirthDate, rnokpp.BirthDate()) {
+		return fmt.Errorf("RNOKPP %s does not match birth date %s", rnokpp, q.BirthDate.Format(dateLayout))
+	}
+
+	return nil
+}
+
+// birthDate
+// Birth date of the query, taken from the RNOKPP when not given
+func (q PersonQuery) birthDate() time.Time {
+	if !q.BirthDate.IsZero() || q.Rnokpp == "" {
+		return q.BirthDate
+	}
+
+	if rnokpp, err := ParseRNOKPP(string(q.Rnokpp)); err == nil {
+		return rnokpp.BirthDate()
+	}
+
+	return time.Time{}
+}
+
+func sameDay(a, b time.Time) bool {
+	return a.Format(dateLayout) == b.Format(dateLayout)
+}
+
+// ScreeningHit is a record of a source that may belong to the applicant
+type ScreeningHit struct {
+	Source     ScreeningSource `json:"source"`     // Джерело
+	Name       string          `json:"name"`       // Ім'я у записі
+	BirthDate  Date            `json:"birth_date"` // Дата народження у записі, якщо є
+	Confidence float64         `json:"confidence"` // Впевненість збігу від 0 до 1
+	Reasons    []string        `json:"reasons"`    // Пояснення впевненості
+	Breakdown  *MatchBreakdown `json:"breakdown"`  // Оцінки частин імені, nil для збігів за номером
+	Record     interface{}     `json:"record"`     // Запис джерела
+}
+
+// ScreeningResult is the outcome of ScreenPerson
+type ScreeningResult struct {
+	Query  PersonQuery               // Запит
+	Hits   []ScreeningHit            // Збіги, від найвпевненіших
+	Errors map[ScreeningSource]error // Джерела, які не вдалося перевірити
+	Counts map[ScreeningSource]int   // Кількість збігів за джерелом
+}
+
+// HitsAbove
+// Hits with the confidence not below min
+func (r *ScreeningResult) HitsAbove(min float64) (hits []ScreeningHit) {
+	for _, hit := range r.Hits {
+		if hit.Confidence >= min {
+			hits = append(hits, hit)
+		}
+	}
+
+	return hits
+}
+
+// IsComplete
+// Reports whether every source was checked
+func (r *ScreeningResult) IsComplete() bool {
+	return len(r.Errors) == 0
+}
+
+// screenHit
+// Hit for the record scored by DefaultNameMatcher,
+// false when the score is below its MinScore
+func (q PersonQuery) screenHit(source ScreeningSource, name string, birthDate Date, record interface{}) (ScreeningHit, bool) {
+	matches := DefaultNameMatcher().Match(q.Name(), q.birthDate(), []NameCandidate{
+		{Name: name, BirthDate: birthDate, Record: record},
+	})
+
+	if len(matches) == 0 {
+		return ScreeningHit{}, false
+	}
+
+	return ScreeningHit{
+		Source:     source,
+		Name:       name,
+		BirthDate:  birthDate,
+		Confidence: matches[0].Score,
+		Reasons:    matches[0].Breakdown.Notes,
+		Breakdown:  &matches[0].Breakdown,
+		Record:     record,
+	}, true
+}
+
+// ScreenPerson
+// Checks the applicant against all person registries in parallel.
+// Records are scored by name and birth date with DefaultNameMatcher; exact passport and RNOKPP hits
+// have the confidence 1. A failed source is reported in Errors and
+// does not fail the screening.
+func (odb *OdbClient) ScreenPerson(ctx context.Context, query PersonQuery) (*ScreeningResult, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := checkApiKey(odb); err != nil {
+		return nil, err
+	}
+
+	if query.Rnokpp != "" {
+		query.Rnokpp, _ = ParseRNOKPP(string(query.Rnokpp))
+	}
+
+	client := odb.WithContext(ctx)
+	result := &ScreeningResult{
+		Query:  query,
+		Errors: map[ScreeningSource]error{},
+		Counts: map[ScreeningSource]int{},
+	}
+
+	var (
+		mutex     sync.Mutex
+		waitGroup sync.WaitGroup
+	)
+
+	for source, screen := range query.screeners(client) {
+		source, screen := source, screen
+
+		waitGroup.Add(1)
+
+		go func() {
+			defer waitGroup.Done()
+
+			hits, err := screen()
+
+			var apiErr *ApiError
+
+			if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+				err = nil
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if err != nil {
+				result.Errors[source] = err
+				return
+			}
+
+			result.Counts[source] = len(hits)
+			result.Hits = append(result.Hits, hits...)
+		}()
+	}
+
+	waitGroup.Wait()
+
+	sort.SliceStable(result.Hits, func(i, j int) bool {
+		if result.Hits[i].Confidence != result.Hits[j].Confidence {
+			return result.Hits[i].Confidence > result.Hits[j].Confidence
+		}
+
+		return result.Hits[i].Source < result.Hits[j].Source
+	})
+
+	return result, nil
+}
+
+// screeners
+// Requests of every source applicable to the query
+func (q PersonQuery) screeners(client *OdbClient) map[ScreeningSource]func() ([]ScreeningHit, error) {
+	pib := q.FullName()
+	birthDate := q.birthDate()
+	limit := strconv.Itoa(screeningLimit)
+
+	screeners := map[ScreeningSource]func() ([]ScreeningHit, error){
+		ScreeningWanted: func() (hits []ScreeningHit, err error) {
+			response, err := client.GetWanted(pib, map[string]string{"limit": limit})
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, item := range response.Data.Items {
+				if hit, ok := q.screenHit(ScreeningWanted, item.FullName, item.BirthDate, item); ok {
+					hits = append(hits, hit)
+				}
+			}
+
+			return hits, nil
+		},
+		ScreeningCorruptOfficials: func() (hits []ScreeningHit, err error) {
+			response, err := client.GetCorruptOfficials(pib, map[string]string{"limit": limit})
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, item := range response.Data.Items {
+				if hit, ok := q.screenHit(ScreeningCorruptOfficials, item.FullName, Date{}, item); ok {
+					hits = append(hits, hit)
+				}
+			}
+
+			return hits, nil
+		},
+		ScreeningAliment: func() (hits []ScreeningHit, err error) {
+			params := map[string]string{"limit": limit}
+
+			if !birthDate.IsZero() {
+				params["birth_date"] = birthDate.Format(dateLayout)
+			}
+
+			response, err := client.GetAliment(pib, params)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, item := range response.Aliments {
+				if hit, ok := q.screenHit(ScreeningAliment, item.FullName, item.BirthDate, item); ok {
+					hits = append(hits, hit)
+				}
+			}
+
+			return hits, nil
+		},
+		ScreeningAccused: func() (hits []ScreeningHit, err error) {
+			response, err := client.GetAccused(map[string]string{"pib": pib, "limit": limit})
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, item := range response.Data.Items {
+				for _, accused := range item.Accused {
+					if hit, ok := q.screenHit(ScreeningAccused, accused, Date{}, item); ok {
+						hits = append(hits, hit)
+					}
+				}
+			}
+
+			return hits, nil
+		},
+		ScreeningLawyers: func() (hits []ScreeningHit, err error) {
+			response, err := client.GetLawyers(map[string]string{"name": pib, "limit": limit})
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, item := range response.Data.Items {
+				if hit, ok := q.screenHit(ScreeningLawyers, item.FullName, Date{}, item); ok {
+					hits = append(hits, hit)
+				}
+			}
+
+			return hits, nil
+		},
+	}
+
+	// The debtors registry is searched by name only together with the birth date
+	if !birthDate.IsZero() {
+		screeners[ScreeningPenalties] = func() (hits []ScreeningHit, err error) {
+			params := map[string]string{}
+
+			if q.MiddleName != "" {
+				params["middle_name"] = q.MiddleName
+			}
+
+			response, err := client.GetPenalties(q.FirstName, q.LastName, birthDate.Format(dateLayout), params)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, item := range response.Data.Items {
+				name := NewPersonName(item.LastName, item.FirstName, item.MiddleName).String()
+
+				if hit, ok := q.screenHit(ScreeningPenalties, name, item.BirthDate, item); ok {
+					hits = append(hits, hit)
+				}
+			}
+
+			return hits, nil
+		}
+	}
+
+	if q.Passport != "" {
+		screeners[ScreeningPassport] = func() (hits []ScreeningHit, err error) {
+			response, err := client.GetPassport(q.Passport)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, item := range response.Data {
+				hits = append(hits, ScreeningHit{
+					Source:     ScreeningPassport,
+					Name:       item.Number,
+					Confidence: 1,
+					Reasons:    []string{"passport_" + item.Type},
+					Record:     item,
+				})
+			}
+
+			return hits, nil
+		}
+	}
+
+	if q.Rnokpp != "" {
+		screeners[ScreeningPenaltiesByCode] = func() (hits []ScreeningHit, err error) {
+			response, err := client.GetPenaltiesByCode(string(q.Rnokpp), map[string]string{})
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, item := range response.Data.Items {
+				hits = append(hits, ScreeningHit{
+					Source:     ScreeningPenaltiesByCode,
+					Name:       NewPersonName(item.LastName, item.FirstName, item.MiddleName).String(),
+					BirthDate:  item.BirthDate,
+					Confidence: 1,
+					Reasons:    []string{"rnokpp"},
+					Record:     item,
+				})
+			}
+
+			return hits, nil
+		}
+	}
+
+	return screeners
+}
@@ -0,0 +1,618 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SubscriptionType is a kind of monitoring subscription
+type SubscriptionType string
+
+const (
+	SubscriptionCompany  SubscriptionType = "company"  // Моніторинг компанії за кодом ЄДРПОУ
+	SubscriptionInn      SubscriptionType = "inn"      // Моніторинг ФОП за ІПН
+	SubscriptionCourt    SubscriptionType = "court"    // Моніторинг судових документів за пошуковим запитом
+	SubscriptionInvolved SubscriptionType = "involved" // Моніторинг судових засідань за входженням в тексті
+	SubscriptionRealty   SubscriptionType = "realty"   // Моніторинг нерухомості за кодом
+)
+
+// IsValid
+// Reports whether the subscription type is one of the known types
+func (t SubscriptionType) IsValid() bool {
+	switch t {
+	case SubscriptionCompany, SubscriptionInn, SubscriptionCourt, SubscriptionInvolved, SubscriptionRealty:
+		return true
+	}
+
+	return false
+}
+
+type SubscriptionItem struct {
+	Id                        string   `json:"id"`                           // Код підписки
+	Type                      string   `json:"type"`                         // Тип підписки
+	TypeName                  string   `json:"type_name"`                    // Ім'я типу підписки
+	Comment                   string   `json:"comment"`                      // Персональний коментар до підписки
+	Created                   DateTime `json:"created"`                      // Дата підписки
+	Name                      string   `json:"name"`                         // Ім'я підписки
+	SearchTerm                string   `json:"search_term"`                  // Пошуковий запит по якому була здійснена підписка
+	CourtSubscriptionSearchId string   `json:"court_subscription_search_id"` // Ідентифікатор судової підписки за кодом компанії
+	CourtSubscription         bool     `json:"court_subscription"`           // Ознака наявності судової підписки за кодом компанії
+}
+
+// Key
+// Value the subscription was created for: the search term of court and involved
+// subscriptions, the id of the others, which is the code they were created with
+// (ЄДРПОУ, ІПН or realty number). A missing value is an error, so that
+// the subscription is not mistaken for another one.
+func (s SubscriptionItem) Key(subscriptionType SubscriptionType) (string, error) {
+	field, key := "id", s.Id
+
+	if subscriptionType == SubscriptionCourt || subscriptionType == SubscriptionInvolved {
+		field, key = "search_term", s.SearchTerm
+	}
+
+	if key = strings.TrimSpace(key); key == "" {
+		return "", fmt.Errorf("Subscription %q of type %s has no %s", s.Name, subscriptionType, field)
+	}
+
+	return key, nil
+}
+
+type Subscriptions struct {
+	Activity bool    `json:"activity"` // Статус операції
+	Total    FlexInt `json:"total"`    // Загальна кількість підписок
+	Data     struct {
+		Legal struct {
+			Count     FlexInt            `json:"count"`
+			Companies []SubscriptionItem `json:"companies"`
+		} `json:"legal"`
+		Court struct {
+			Count     FlexInt            `json:"count"`
+			Companies []SubscriptionItem `json:"companies"`
+		} `json:"court"`
+		Involved struct {
+			Count    FlexInt            `json:"count"`
+			Involved []SubscriptionItem `json:"involved"`
+		} `json:"involved"`
+		Realty struct {
+			Count  FlexInt            `json:"count"`
+			Realty []SubscriptionItem `json:"realty"`
+		} `json:"realty"`
+		InnSubscribe struct {
+			Count        FlexInt            `json:"count"`
+			InnSubscribe []SubscriptionItem `json:"innSubscribe"`
+		} `json:"innSubscribe"`
+		FullPenaltySecret struct {
+			Count             FlexInt            `json:"count"`
+			FullPenaltySecret []SubscriptionItem `json:"fullPenaltySecret"`
+		} `json:"fullPenaltySecret"`
+	} `json:"data"`
+}
+
+// ByType
+// Subscriptions of the account grouped by subscription type
+func (s *Subscriptions) ByType() map[SubscriptionType][]SubscriptionItem {
+	return map[SubscriptionType][]SubscriptionItem{
+		SubscriptionCompany:  s.Data.Legal.Companies,
+		SubscriptionInn:      s.Data.InnSubscribe.InnSubscribe,
+		SubscriptionCourt:    s.Data.Court.Companies,
+		SubscriptionInvolved: s.Data.Involved.Involved,
+		SubscriptionRealty:   s.Data.Realty.Realty,
+	}
+}
+
+// GetSubscriptions
+// Отримання списку підписок
+func (odb *OdbClient) GetSubscriptions() (response *Subscriptions, err error) {
+	if err = checkApiKey(odb); err != nil {
+		return nil, err
+	}
+
+	err = odb.Do(subscriptionsEndpoint, map[string]string{}, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+	//{
+	//  "activity": true,
+	//  "total": 4,
+	//  "data": {
+	//    "legal": {
+	//      "count": 1,
+	//      "companies": [
+	//        {
+	//          "id": "13429058",
+	//          "type": "1",
+	//          "type_name": "company",
+	//          "comment": "Партнер",
+	//          "created": "2017-01-01 00:00:00",
+	//          "name": "ФІРМА АЛЬФА",
+	//          "court_subscription_search_id": "818189",
+	//          "court_subscription": true
+	//        }
+	//      ]
+	//    },
+	//    "court": {
+	//      "count": 1,
+	//      "companies": [
+	//        {
+	//          "id": "13429058",
+	//          "search_term": "ФІРМА АЛЬФА",
+	//          "type": "1",
+	//          "type_name": "company",
+	//          "comment": "Партнер",
+	//          "name": "ФІРМА АЛЬФА"
+	//        }
+	//      ]
+	//    }
+	//  }
+	//}
+}
+
+type SubscriptionResult struct {
+	Status string `json:"status"` // Статус операції
+	Data   struct {
+		Id string `json:"id"` // Код підписки
+	} `json:"data"`
+}
+
+// AddSubscription
+// Створення підписки на моніторинг
+func (odb *OdbClient) AddSubscription(
+	subscriptionType SubscriptionType, // Тип підписки
+	key string, // Код ЄДРПОУ, ІПН або пошуковий запит
+	comment string, // Персональний коментар до підписки
+) (response *SubscriptionResult, err error) {
+	return odb.addSubscription(context.Background(), subscriptionType, key, comment)
+}
+
+func (odb *OdbClient) addSubscription(
+	ctx context.Context,
+	subscriptionType SubscriptionType,
+	key string,
+	comment string,
+) (response *SubscriptionResult, err error) {
+	if err = checkNotEmpty(key); err != nil {
+		return nil, err
+	}
+
+	if err = checkApiKey(odb); err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{
+		"type":            string(subscriptionType),
+		"subscriptionKey": key,
+	}
+
+	if comment != "" {
+		params["comment"] = comment
+	}
+
+	err = odb.DoContext(ctx, http.MethodPost, subscriptionsEndpoint, params, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+	//{
+	//  "status": "ok",
+	//  "data": {
+	//    "id": "13429058"
+	//  }
+	//}
+}
+
+// DeleteSubscription
+// Видалення підписки за кодом підписки
+func (odb *OdbClient) DeleteSubscription(
+	id string, // Код підписки
+) (err error) {
+	return odb.deleteSubscription(context.Background(), id)
+}
+
+func (odb *OdbClient) deleteSubscription(ctx context.Context, id string) (err error) {
+	if err = checkNotEmpty(id); err != nil {
+		return err
+	}
+
+	if err = checkApiKey(odb); err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf(subscriptionEndpoint, id)
+
+	return odb.DoContext(ctx, http.MethodDelete, endpoint, map[string]string{}, nil)
+}
+
+// DesiredSubscription is one entry of a desired subscription set.
+// In YAML/JSON it may be written either as an object or as a plain key string.
+type DesiredSubscription struct {
+	Key     string `json:"key" yaml:"key"`                             // Код ЄДРПОУ, ІПН або пошуковий запит
+	Comment string `json:"comment,omitempty" yaml:"comment,omitempty"` // Персональний коментар до підписки
+}
+
+func (d *DesiredSubscription) UnmarshalJSON(data []byte) error {
+	var key string
+
+	if err := json.Unmarshal(data, &key); err == nil {
+		*d = DesiredSubscription{Key: key}
+
+		return nil
+	}
+
+	type plain DesiredSubscription
+
+	return json.Unmarshal(data, (*plain)(d))
+}
+
+func (d *DesiredSubscription) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*d = DesiredSubscription{Key: value.Value}
+
+		return nil
+	}
+
+	type plain DesiredSubscription
+
+	return value.Decode((*plain)(d))
+}
+
+// SubscriptionSet is the desired state of the account subscriptions.
+// Only types present in the set are managed: an empty list removes
+// every subscription of that type, a missing type is left untouched.
+//
+//	company:
+//	  - key: "41711425"
+//	    comment: Партнер
+//	  - "32746583"
+//	inn:
+//	  - "1234567890"
+//	court:
+//	  - key: ФІРМА АЛЬФА
+type SubscriptionSet map[SubscriptionType][]DesiredSubscription
+
+// ParseSubscriptionSet
+// Parses a desired subscription set from JSON or YAML
+func ParseSubscriptionSet(data []byte) (set SubscriptionSet, err error) {
+	if err = yaml.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+// Validate
+// Checks that every subscription type of the set is known
+func (s SubscriptionSet) Validate() error {
+	for subscriptionType := range s {
+		if !subscriptionType.IsValid() {
+			return fmt.Errorf("Unknown subscription type %q", subscriptionType)
+		}
+	}
+
+	return nil
+}
+
+// LoadSubscriptionSet
+// Reads a desired subscription set from a .json, .yaml or .yml file
+func LoadSubscriptionSet(path string) (set SubscriptionSet, err error) {
+	data, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		if err = json.Unmarshal(data, &set); err != nil {
+			return nil, err
+		}
+
+		return set, nil
+	}
+
+	return ParseSubscriptionSet(data)
+}
+
+// SubscriptionAction is a planned change of a subscription
+type SubscriptionAction string
+
+const (
+	SubscriptionCreate SubscriptionAction = "create"
+	SubscriptionUpdate SubscriptionAction = "update" // Нова підписка з іншим коментарем замість існуючої
+	SubscriptionDelete SubscriptionAction = "delete"
+)
+
+type SubscriptionChange struct {
+	Action  SubscriptionAction
+	Type    SubscriptionType
+	Key     string
+	Comment string
+	Id      string // Код підписки, для видалення та оновлення
+}
+
+func (c SubscriptionChange) String() string {
+	sign := "+"
+
+	switch c.Action {
+	case SubscriptionUpdate:
+		sign = "~"
+	case SubscriptionDelete:
+		sign = "-"
+	}
+
+	line := fmt.Sprintf("%s %s %s", sign, c.Type, c.Key)
+
+	if c.Comment != "" {
+		line += fmt.Sprintf(" (%s)", c.Comment)
+	}
+
+	return line
+}
+
+// SubscriptionPlan is the list of changes needed to reach the desired set
+type SubscriptionPlan struct {
+	Changes   []SubscriptionChange
+	Unchanged int // Кількість підписок, що вже відповідають бажаному стану
+}
+
+// Empty
+// Reports whether the account already matches the desired set
+func (p *SubscriptionPlan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String
+// Human readable dry-run output of the plan
+func (p *SubscriptionPlan) String() string {
+	var b strings.Builder
+	counts := map[SubscriptionAction]int{}
+
+	for _, change := range p.Changes {
+		b.WriteString(change.String())
+		b.WriteString("\n")
+
+		counts[change.Action]++
+	}
+
+	fmt.Fprintf(&b, "Plan: %d to create, %d to update, %d to delete, %d unchanged.\n",
+		counts[SubscriptionCreate], counts[SubscriptionUpdate], counts[SubscriptionDelete], p.Unchanged)
+
+	return b.String()
+}
+
+// DiffSubscriptions
+// Compares the desired set with the current account subscriptions.
+// Unknown subscription types are an error. A subscription whose comment differs
+// is updated, see ApplySubscriptionPlan. Creations and updates come before deletions,
+// so a failed request never leaves a desired key without monitoring.
+func DiffSubscriptions(desired SubscriptionSet, current *Subscriptions) (*SubscriptionPlan, error) {
+	if err := desired.Validate(); err != nil {
+		return nil, err
+	}
+
+	plan := &SubscriptionPlan{}
+	existing := current.ByType()
+	var creates, updates, deletes []SubscriptionChange
+
+	types := make([]string, 0, len(desired))
+
+	for subscriptionType := range desired {
+		types = append(types, string(subscriptionType))
+	}
+
+	sort.Strings(types)
+
+	for _, name := range types {
+		subscriptionType := SubscriptionType(name)
+		wanted := map[string]DesiredSubscription{}
+
+		for _, item := range desired[subscriptionType] {
+			key := strings.TrimSpace(item.Key)
+
+			if key == "" {
+				continue
+			}
+
+			item.Key = key
+			wanted[key] = item
+		}
+
+		have := map[string]bool{}
+
+		for _, item := range existing[subscriptionType] {
+			key, err := item.Key(subscriptionType)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if want, ok := wanted[key]; ok && !have[key] {
+				have[key] = true
+
+				if want.Comment == item.Comment {
+					plan.Unchanged++
+				} else {
+					updates = append(updates, SubscriptionChange{
+						Action:  SubscriptionUpdate,
+						Type:    subscriptionType,
+						Key:     key,
+						Comment: want.Comment,
+						Id:      item.Id,
+					})
+				}
+
+				continue
+			}
+
+			deletes = append(deletes, SubscriptionChange{
+				Action:  SubscriptionDelete,
+				Type:    subscriptionType,
+				Key:     key,
+				Comment: item.Comment,
+				Id:      item.Id,
+			})
+		}
+
+		keys := make([]string, 0, len(wanted))
+
+		for key := range wanted {
+			if !have[key] {
+				keys = append(keys, key)
+			}
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			creates = append(creates, SubscriptionChange{
+				Action:  SubscriptionCreate,
+				Type:    subscriptionType,
+				Key:     key,
+				Comment: wanted[key].Comment,
+			})
+		}
+	}
+
+	plan.Changes = append(append(creates, updates...), deletes...)
+
+	return plan, nil
+}
+
+// PlanSubscriptions
+// Loads current subscriptions and returns the plan to reach the desired set
+func (odb *OdbClient) PlanSubscriptions(ctx context.Context, desired SubscriptionSet) (plan *SubscriptionPlan, err error) {
+	current, err := odb.WithContext(ctx).GetSubscriptions()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffSubscriptions(desired, current)
+}
+
+// subscriptionRetries is how many times a rate limited change is retried
+const subscriptionRetries = 5
+
+// ApplySubscriptionPlan
+// Executes the plan one request at a time, pausing interval between requests.
+// Rate limited requests are retried with exponential backoff.
+// Applied changes are returned even when an error stops the run,
+// so planning again afterwards only contains what is left.
+// The API has no way to change a comment, so an update subscribes again with
+// the new comment and deletes the old subscription only when a new one was created:
+// for subscriptions keyed by their id the API updates the existing one.
+func (odb *OdbClient) ApplySubscriptionPlan(
+	ctx context.Context,
+	plan *SubscriptionPlan,
+	interval time.Duration, // пауза між запитами
+) (applied []SubscriptionChange, err error) {
+	for i, change := range plan.Changes {
+		if i > 0 {
+			if err = sleepContext(ctx, interval); err != nil {
+				return applied, err
+			}
+		}
+
+		backoff := interval
+
+		if backoff < time.Second {
+			backoff = time.Second
+		}
+
+		for attempt := 0; ; attempt++ {
+			err = odb.applySubscriptionChange(ctx, change)
+
+			var apiErr *ApiError
+
+			if err == nil || !errors.As(err, &apiErr) || !apiErr.IsRateLimited() || attempt == subscriptionRetries {
+				break
+			}
+
+			if err = sleepContext(ctx, backoff); err != nil {
+				return applied, err
+			}
+
+			backoff *= 2
+		}
+
+		if err != nil {
+			return applied, fmt.Errorf("%s: %w", change, err)
+		}
+
+		applied = append(applied, change)
+	}
+
+	return applied, nil
+}
+
+// SyncSubscriptions
+// Plans and applies the desired set. With dryRun only the plan is returned.
+func (odb *OdbClient) SyncSubscriptions(
+	ctx context.Context,
+	desired SubscriptionSet,
+	dryRun bool,
+	interval time.Duration, // пауза між запитами
+) (plan *SubscriptionPlan, err error) {
+	plan, err = odb.PlanSubscriptions(ctx, desired)
+
+	if err != nil || dryRun {
+		return plan, err
+	}
+
+	_, err = odb.ApplySubscriptionPlan(ctx, plan, interval)
+
+	return plan, err
+}
+
+func (odb *OdbClient) applySubscriptionChange(ctx context.Context, change SubscriptionChange) error {
+	if change.Action == SubscriptionDelete {
+		return odb.deleteSubscription(ctx, change.Id)
+	}
+
+	result, err := odb.addSubscription(ctx, change.Type, change.Key, change.Comment)
+
+	if err != nil || change.Action != SubscriptionUpdate {
+		return err
+	}
+
+	// The old subscription is the same one when its id is the key or the returned id
+	if change.Id == change.Key || (result != nil && result.Data.Id == change.Id) {
+		return nil
+	}
+
+	return odb.deleteSubscription(ctx, change.Id)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// fakeSubscriptions is an account answering the subscriptions endpoints
+// with items shaped like the spec: only search terms carry the key
+// of court subscriptions, the id is the key of the others,
+// and subscribing again to such a key updates its comment
+type fakeSubscriptions struct {
+	items  map[SubscriptionType][]SubscriptionItem
+	nextId int
+}
+
+func (f *fakeSubscriptions) handle(req *http.Request) (int, interface{}) {
+	query := req.URL.Query()
+
+	switch req.Method {
+	case http.MethodGet:
+		var response Subscriptions
+
+		response.Data.Legal.Companies = f.items[SubscriptionCompany]
+		response.Data.InnSubscribe.InnSubscribe = f.items[SubscriptionInn]
+		response.Data.Court.Companies = f.items[SubscriptionCourt]
+		response.Data.Involved.Involved = f.items[SubscriptionInvolved]
+		response.Data.Realty.Realty = f.items[SubscriptionRealty]
+
+		return http.StatusOK, response
+	case http.MethodPost:
+		subscriptionType := SubscriptionType(query.Get("type"))
+		item := SubscriptionItem{Id: query.Get("subscriptionKey"), Name: "ФІРМА АЛЬФА", Comment: query.Get("comment")}
+
+		if subscriptionType == SubscriptionCourt || subscriptionType == SubscriptionInvolved {
+			f.nextId++
+			item.Id = strconv.Itoa(f.nextId)
+			item.SearchTerm = query.Get("subscriptionKey")
+		} else {
+			for i := range f.items[subscriptionType] {
+				if existing := &f.items[subscriptionType][i]; existing.Id == item.Id {
+					existing.Comment = item.Comment
+
+					return http.StatusOK, subscriptionResult(item.Id)
+				}
+			}
+		}
+
+		f.items[subscriptionType] = append(f.items[subscriptionType], item)
+
+		return http.StatusOK, subscriptionResult(item.Id)
+	case http.MethodDelete:
+		id := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+
+		for subscriptionType, items := range f.items {
+			for i, item := range items {
+				if item.Id == id {
+					f.items[subscriptionType] = append(items[:i:i], items[i+1:]...)
+
+					return http.StatusOK, nil
+				}
+			}
+		}
+	}
+
+	return http.StatusNotFound, nil
+}
+
+func subscriptionResult(id string) (result SubscriptionResult) {
+	result.Status = "ok"
+	result.Data.Id = id
+
+	return result
+}
+
+func TestSyncSubscriptionsIsIdempotent(t *testing.T) {
+	account := &fakeSubscriptions{items: map[SubscriptionType][]SubscriptionItem{
+		SubscriptionCompany: {{Id: "00000001", Name: "СТАРА ФІРМА"}},
+		SubscriptionInn:     {{Id: "1234567890", Name: "ІВАНОВ", Comment: "Старий"}},
+	}}
+	client := newTestClient(t, account.handle)
+
+	desired, err := ParseSubscriptionSet([]byte(`
+company:
+  - key: "41711425"
+    comment: Партнер
+  - "32746583"
+inn:
+  - key: "1234567890"
+    comment: Новий
+court:
+  - ФІРМА АЛЬФА
+realty:
+  - "1234567"
+`))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := client.SyncSubscriptions(context.Background(), desired, false, 0)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(plan.Changes) != 6 {
+		t.Fatalf("first plan has %d changes, want 6:\n%s", len(plan.Changes), plan)
+	}
+
+	if last := plan.Changes[len(plan.Changes)-1]; last.Action != SubscriptionDelete || plan.Changes[0].Action != SubscriptionCreate {
+		t.Errorf("deletions do not come after creations:\n%s", plan)
+	}
+
+	plan, err = client.SyncSubscriptions(context.Background(), desired, false, 0)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !plan.Empty() || plan.Unchanged != 5 {
+		t.Fatalf("second plan is not empty:\n%s", plan)
+	}
+}
+
+func TestDiffSubscriptionsRejectsItemsWithoutKey(t *testing.T) {
+	current := &Subscriptions{}
+	current.Data.Court.Companies = []SubscriptionItem{{Id: "1", Name: "ФІРМА АЛЬФА"}}
+
+	if _, err := DiffSubscriptions(SubscriptionSet{SubscriptionCourt: nil}, current); err == nil {
+		t.Fatal("court subscription without search_term was accepted")
+	}
+}
+
+func TestPlanSubscriptionsUsesContext(t *testing.T) {
+	client := newTestClient(t, (&fakeSubscriptions{}).handle)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := client.SyncSubscriptions(ctx, SubscriptionSet{}, true, 0); err == nil {
+		t.Fatal("canceled context was ignored while planning")
+	}
+}
+
+func TestDiffSubscriptionsRejectsUnknownTypes(t *testing.T) {
+	if _, err := DiffSubscriptions(SubscriptionSet{"compnay": {{Key: "41711425"}}}, &Subscriptions{}); err == nil {
+		t.Fatal("unknown subscription type was planned")
+	}
+}
+
+func TestSyncSubscriptionsUpdatesCourtComment(t *testing.T) {
+	account := &fakeSubscriptions{nextId: 10, items: map[SubscriptionType][]SubscriptionItem{
+		SubscriptionCourt: {{Id: "7", Name: "ФІРМА АЛЬФА", SearchTerm: "ФІРМА АЛЬФА", Comment: "Старий"}},
+	}}
+	client := newTestClient(t, account.handle)
+	desired := SubscriptionSet{SubscriptionCourt: {{Key: "ФІРМА АЛЬФА", Comment: "Новий"}}}
+
+	plan, err := client.SyncSubscriptions(context.Background(), desired, false, 0)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(plan.Changes) != 1 || plan.Changes[0].Action != SubscriptionUpdate {
+		t.Fatalf("plan:\n%s", plan)
+	}
+
+	items := account.items[SubscriptionCourt]
+
+	if len(items) != 1 || items[0].Id != "11" || items[0].Comment != "Новий" {
+		t.Errorf("court subscriptions after the update: %+v", items)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import (
+	"strings"
+	"unicode"
+)
+
+// translitTable is the Ukrainian to Latin transliteration table
+// of the Cabinet of Ministers resolution №55 of 27.01.2010.
+// Letters with two forms have the form used at the beginning of a word first.
+var translitTable = map[rune][2]string{
+	'а': {"a", "a"},
+	'б': {"b", "b"},
+	'в': {"v", "v"},
+	'г': {"h", "h"},
+	'ґ': {"g", "g"},
+	'д': {"d", "d"},
+	'е': {"e", "e"},
+	'є': {"ye", "ie"},
+	'ж': {"zh", "zh"},
+	'з': {"z", "z"},
+	'и': {"y", "y"},
+	'і': {"i", "i"},
+	'ї': {"yi", "i"},
+	'й': {"y", "i"},
+	'к': {"k", "k"},
+	'л': {"l", "l"},
+	'м': {"m", "m"},
+	'н': {"n", "n"},
+	'о': {"o", "o"},
+	'п': {"p", "p"},
+	'р': {"r", "r"},
+	'с': {"s", "s"},
+	'т': {"t", "t"},
+	'у': {"u", "u"},
+	'ф': {"f", "f"},
+	'х': {"kh", "kh"},
+	'ц': {"ts", "ts"},
+	'ч': {"ch", "ch"},
+	'ш': {"sh", "sh"},
+	'щ': {"shch", "shch"},
+	'ь': {"", ""},
+	'ю': {"yu", "iu"},
+	'я': {"ya", "ia"},
+	// Not in the standard, met in registry records
+	'ё': {"yo", "io"},
+	'ъ': {"", ""},
+	'ы': {"y", "y"},
+	'э': {"e", "e"},
+}
+
+// isApostrophe reports whether the rune is used as an apostrophe
+func isApostrophe(r rune) bool {
+	switch r {
+	case '\'', 'ʼ', '’', '‘', '`', '′', '´':
+		return true
+	}
+
+	return false
+}
+
+// Transliterate
+// Converts Ukrainian text to Latin by the Cabinet of Ministers 2010 standard:
+// Є, Ї, Й, Ю, Я have their "y" forms only at the beginning of a word,
+// "зг" is written "zgh", the soft sign and apostrophes are dropped.
+// Other characters are kept as is, the case of every letter is preserved.
+func Transliterate(text string) string {
+	runes := []rune(text)
+
+	var result strings.Builder
+
+	for i, r := range runes {
+		lower := unicode.ToLower(r)
+		forms, ok := translitTable[lower]
+
+		if !ok {
+			if isApostrophe(r) && i > 0 && i+1 < len(runes) && isCyrillicLetter(runes[i-1]) && isCyrillicLetter(runes[i+1]) {
+				continue
+			}
+
+			result.WriteRune(r)
+			continue
+		}
+
+		latin := forms[1]
+
+		if i == 0 || !(unicode.IsLetter(runes[i-1]) || isApostrophe(runes[i-1])) {
+			latin = forms[0]
+		}
+
+		if lower == 'г' && i > 0 && unicode.ToLower(runes[i-1]) == 'з' {
+			latin = "gh"
+		}
+
+		result.WriteString(translitCase(latin, runes, i))
+	}
+
+	return result.String()
+}
+
+func isCyrillicLetter(r rune) bool {
+	return unicode.Is(unicode.Cyrillic, r)
+}
+
+// translitCase
+// Applies the case of runes[i] to its Latin form. A capital letter becomes
+// all caps when a neighbouring letter is a capital too ("ЩУКА" -> "SHCHUKA"),
+// otherwise only its first letter is capitalized ("Щука" -> "Shchuka").
+func translitCase(latin string, runes []rune, i int) string {
+	if latin == "" || !unicode.IsUpper(runes[i]) {
+		return latin
+	}
+
+	neighbourUpper := func(j int) bool {
+		return j >= 0 && j < len(runes) && unicode.IsLetter(runes[j]) && unicode.IsUpper(runes[j])
+	}
+
+	if neighbourUpper(i+1) || (neighbourUpper(i-1) && !(i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+		return strings.ToUpper(latin)
+	}
+
+	return strings.ToUpper(latin[:1]) + latin[1:]
+}
+
+// Latin
+// Transliterated name, e.g. "Shevchenko Taras Hryhorovych"
+func (n PersonName) Latin() PersonName {
+	return PersonName{
+		Last:   Transliterate(n.Last),
+		First:  Transliterate(n.First),
+		Middle: Transliterate(n.Middle),
+	}
+}
+
+// LatinForms holds Latin forms of names and addresses of a record
+type LatinForms struct {
+	FullName      string   // Повна назва або ПІБ
+	ShortName     string   // Скорочена назва
+	CeoName       string   // ПІБ керівника
+	Location      string   // Адреса
+	Beneficiaries []string // Засновники та бенефіціари
+}
+
+// Latin
+// Transliterated names and address of the company
+func (c *CompanyData) Latin() LatinForms {
+	forms := LatinForms{
+		FullName:  Transliterate(c.FullName),
+		ShortName: Transliterate(c.ShortName),
+		CeoName:   Transliterate(c.CeoName),
+		Location:  Transliterate(c.Location),
+	}
+
+	for _, beneficiary := range c.Beneficiaries {
+		forms.Beneficiaries = append(forms.Beneficiaries, Transliterate(beneficiary.Title))
+	}
+
+	return forms
+}
+
+// Latin
+// Names and address of the company in Latin,
+// the English names from the registry are preferred to transliteration
+func (c *Fullcompany) Latin() LatinForms {
+	forms := LatinForms{
+		FullName:  c.FullNameEn,
+		ShortName: c.ShortNameEn,
+		CeoName:   Transliterate(c.CeoName),
+		Location:  Transliterate(c.Location),
+	}
+
+	if forms.FullName == "" {
+		forms.FullName = Transliterate(c.FullName)
+	}
+
+	if forms.ShortName == "" {
+		forms.ShortName = Transliterate(c.ShortName)
+	}
+
+	for _, beneficiary := range c.Beneficiaries {
+		forms.Beneficiaries = append(forms.Beneficiaries, Transliterate(beneficiary.Name))
+	}
+
+	return forms
+}
+
+// Latin
+// Transliterated name and address of the FOP
+func (f *FopDpa) Latin() LatinForms {
+	return LatinForms{
+		FullName: Transliterate(f.FullName),
+		Location: Transliterate(f.Address.String()),
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 Omelchuk Rostyslav <work@rostyslav.io>
+// This software may be modified and distributed under the terms
+// of the MIT license. See the LICENSE file for details.
+
+package odb
+
+import "testing"
+
+// TestTransliterate checks the examples of the Cabinet of Ministers resolution №55 of 27.01.2010
+func TestTransliterate(t *testing.T) {
+	tests := []struct {
+		text, want string
+	}{
+		{"Алушта", "Alushta"},
+		{"Андрій", "Andrii"},
+		{"Борщагівка", "Borshchahivka"},
+		{"Борисенко", "Borysenko"},
+		{"Вінниця", "Vinnytsia"},
+		{"Володимир", "Volodymyr"},
+		{"Гадяч", "Hadiach"},
+		{"Богдан", "Bohdan"},
+		{"Згурський", "Zghurskyi"},
+		{"Ґалаґан", "Galagan"},
+		{"Ґорґани", "Gorgany"},
+		{"Донецьк", "Donetsk"},
+		{"Дмитро", "Dmytro"},
+		{"Рівне", "Rivne"},
+		{"Олег", "Oleh"},
+		{"Есмань", "Esman"},
+		// Є at the beginning of a word and inside it
+		{"Єнакієве", "Yenakiieve"},
+		{"Гаєвич", "Haievych"},
+		{"Короп'є", "Koropie"},
+		{"Житомир", "Zhytomyr"},
+		{"Жанна", "Zhanna"},
+		{"Жежелів", "Zhezheliv"},
+		{"Закарпаття", "Zakarpattia"},
+		{"Казимирчук", "Kazymyrchuk"},
+		{"Медвин", "Medvyn"},
+		{"Михайленко", "Mykhailenko"},
+		{"Іванків", "Ivankiv"},
+		{"Іващенко", "Ivashchenko"},
+		// Ї at the beginning of a word and inside it
+		{"Їжакевич", "Yizhakevych"},
+		{"Кадиївка", "Kadyivka"},
+		{"Мар'їне", "Marine"},
+		// Й at the beginning of a word and inside it
+		{"Йосипівка", "Yosypivka"},
+		{"Стрий", "Stryi"},
+		{"Олексій", "Oleksii"},
+		{"Київ", "Kyiv"},
+		{"Коваленко", "Kovalenko"},
+		{"Лебедин", "Lebedyn"},
+		{"Леонід", "Leonid"},
+		{"Миколаїв", "Mykolaiv"},
+		{"Маринич", "Marynych"},
+		{"Ніжин", "Nizhyn"},
+		{"Наталія", "Nataliia"},
+		{"Одеса", "Odesa"},
+		{"Онищенко", "Onyshchenko"},
+		{"Полтава", "Poltava"},
+		{"Петро", "Petro"},
+		{"Решетилівка", "Reshetylivka"},
+		{"Рибчинський", "Rybchynskyi"},
+		{"Суми", "Sumy"},
+		{"Соломія", "Solomiia"},
+		{"Тернопіль", "Ternopil"},
+		{"Троць", "Trots"},
+		{"Ужгород", "Uzhhorod"},
+		{"Уляна", "Uliana"},
+		{"Фастів", "Fastiv"},
+		{"Філіпчук", "Filipchuk"},
+		{"Харків", "Kharkiv"},
+		{"Христина", "Khrystyna"},
+		{"Біла Церква", "Bila Tserkva"},
+		{"Стеценко", "Stetsenko"},
+		{"Чернівці", "Chernivtsi"},
+		{"Шевченко", "Shevchenko"},
+		{"Шостка", "Shostka"},
+		{"Кишеньки", "Kyshenky"},
+		{"Щербухи", "Shcherbukhy"},
+		{"Гоща", "Hoshcha"},
+		{"Гаращенко", "Harashchenko"},
+		// Ю at the beginning of a word and inside it
+		{"Юрій", "Yurii"},
+		{"Корюківка", "Koriukivka"},
+		// Я at the beginning of a word and inside it
+		{"Яготин", "Yahotyn"},
+		{"Ярошенко", "Yaroshenko"},
+		{"Костянтин", "Kostiantyn"},
+		{"Знам'янка", "Znamianka"},
+		{"Феодосія", "Feodosiia"},
+		// "зг" is written "zgh"
+		{"Згорани", "Zghorany"},
+		{"Розгон", "Rozghon"},
+		// Apostrophes of any kind are dropped
+		{"Знамʼянка", "Znamianka"},
+		{"Знам’янка", "Znamianka"},
+		{"Кам'янець-Подільський", "Kamianets-Podilskyi"},
+		// All caps
+		{"ЩУКА", "SHCHUKA"},
+		{"ЖИТОМИР", "ZHYTOMYR"},
+		{"ЄНАКІЄВЕ", "YENAKIIEVE"},
+		{"ЮРІЙ ЯРОШЕНКО", "YURII YAROSHENKO"},
+		{"ЗГОРАНИ", "ZGHORANY"},
+		{"ШЕВЧЕНКО Тарас", "SHEVCHENKO Taras"},
+		// Other characters are kept
+		{"вул. Хрещатик, 22", "vul. Khreshchatyk, 22"},
+		{"Kyiv", "Kyiv"},
+	}
+
+	for _, test := range tests {
+		if got := Transliterate(test.text); got != test.want {
+			t.Errorf("Transliterate(%q) = %q, want %q", test.text, got, test.want)
+		}
+	}
+}